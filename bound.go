@@ -41,7 +41,12 @@ func (b Bound) ToRing() Ring {
 }
 
 // Extend grows the bound to include the new point.
+// An empty bound is extended to contain only the point.
 func (b Bound) Extend(point Point) Bound {
+	if b.IsEmpty() {
+		return Bound{Min: point, Max: point}
+	}
+
 	// already included, no big deal
 	if b.Contains(point) {
 		return b
@@ -60,11 +65,17 @@ func (b Bound) Extend(point Point) Bound {
 }
 
 // Union extends this bound to contain the union of this and the given bound.
+// Empty bounds are ignored, so an empty bound can be used as the starting
+// value when combining many bounds.
 func (b Bound) Union(other Bound) Bound {
 	if other.IsEmpty() {
 		return b
 	}
 
+	if b.IsEmpty() {
+		return other
+	}
+
 	b = b.Extend(other.Min)
 	b = b.Extend(other.Max)
 	b = b.Extend(other.LeftTop())
@@ -100,6 +111,39 @@ func (b Bound) Intersects(bound Bound) bool {
 	return true
 }
 
+// OverlapRatio returns the area of the intersection of the two bounds
+// divided by the area of their union, also known as the intersection over union.
+// The result is 0 if the bounds are disjoint and 1 if they are identical,
+// including identical bounds with zero width or height, e.g. the same point.
+// Empty bounds, and other bounds with zero union area, return 0.
+func (b Bound) OverlapRatio(other Bound) float64 {
+	if b.IsEmpty() || other.IsEmpty() {
+		return 0
+	}
+
+	if b == other {
+		return 1
+	}
+
+	w := math.Min(b.Max[0], other.Max[0]) - math.Max(b.Min[0], other.Min[0])
+	h := math.Min(b.Max[1], other.Max[1]) - math.Max(b.Min[1], other.Min[1])
+	if w <= 0 || h <= 0 {
+		return 0
+	}
+
+	intersection := w * h
+	union := boundArea(b) + boundArea(other) - intersection
+	if union <= 0 {
+		return 0
+	}
+
+	return intersection / union
+}
+
+func boundArea(b Bound) float64 {
+	return (b.Max[0] - b.Min[0]) * (b.Max[1] - b.Min[1])
+}
+
 // Pad extends the bound in all directions by the given value.
 func (b Bound) Pad(d float64) Bound {
 	b.Min[0] -= d
@@ -111,6 +155,60 @@ func (b Bound) Pad(d float64) Bound {
 	return b
 }
 
+// PadPercent extends the bound on each side by the given fraction
+// of the width, for left and right, or height, for bottom and top.
+// e.g. a bottom value of 0.1 extends the bottom by 10% of the height.
+// Negative values shrink the bound.
+func (b Bound) PadPercent(left, bottom, right, top float64) Bound {
+	w := b.Max[0] - b.Min[0]
+	h := b.Max[1] - b.Min[1]
+
+	b.Min[0] -= left * w
+	b.Min[1] -= bottom * h
+
+	b.Max[0] += right * w
+	b.Max[1] += top * h
+
+	return b
+}
+
+// Grid divides the bound into a grid of cols by rows cells of equal size.
+// The cells are returned in row-major order, i.e. grid[row][col], with the
+// first row at the bottom and the first column on the left. Neighboring
+// cells share their edges exactly. Returns nil if cols or rows is less than 1.
+func (b Bound) Grid(cols, rows int) [][]Bound {
+	if cols < 1 || rows < 1 {
+		return nil
+	}
+
+	xs := gridLines(b.Min[0], b.Max[0], cols)
+	ys := gridLines(b.Min[1], b.Max[1], rows)
+
+	grid := make([][]Bound, rows)
+	for r := range grid {
+		grid[r] = make([]Bound, cols)
+		for c := range grid[r] {
+			grid[r][c] = Bound{
+				Min: Point{xs[c], ys[r]},
+				Max: Point{xs[c+1], ys[r+1]},
+			}
+		}
+	}
+
+	return grid
+}
+
+// gridLines returns the n+1 values dividing min to max into n equal parts.
+func gridLines(min, max float64, n int) []float64 {
+	lines := make([]float64, n+1)
+	for i := range lines {
+		lines[i] = min + (max-min)*float64(i)/float64(n)
+	}
+	lines[n] = max
+
+	return lines
+}
+
 // Center returns the center of the bounds by "averaging" the x and y coords.
 func (b Bound) Center() Point {
 	return Point{
@@ -161,11 +259,56 @@ func (b Bound) IsZero() bool {
 	return b.Max == Point{} && b.Min == Point{}
 }
 
+// IsZeroWithin returns true if all the corner coordinates of the bound are
+// within epsilon of zero, e.g. a null island bound that picked up some
+// floating point error after a projection round trip.
+func (b Bound) IsZeroWithin(epsilon float64) bool {
+	return math.Abs(b.Min[0]) <= epsilon && math.Abs(b.Min[1]) <= epsilon &&
+		math.Abs(b.Max[0]) <= epsilon && math.Abs(b.Max[1]) <= epsilon
+}
+
 // Bound returns the the same bound.
 func (b Bound) Bound() Bound {
 	return b
 }
 
+// String returns a readable representation of the bound
+// in the form "[min=(x,y), max=(x,y)]". Empty bounds return "EMPTY".
+func (b Bound) String() string {
+	if b.IsEmpty() {
+		return "EMPTY"
+	}
+
+	buf := make([]byte, 0, 64)
+	buf = append(buf, "[min=("...)
+	buf = appendCoord(buf, b.Min, ',')
+	buf = append(buf, "), max=("...)
+	buf = appendCoord(buf, b.Max, ',')
+	buf = append(buf, ")]"...)
+
+	return string(buf)
+}
+
+// WKT returns the bound as a well-known text polygon envelope,
+// e.g. "POLYGON((0 0,1 0,1 1,0 1,0 0))". Empty bounds return "POLYGON EMPTY".
+func (b Bound) WKT() string {
+	if b.IsEmpty() {
+		return "POLYGON EMPTY"
+	}
+
+	buf := make([]byte, 0, 128)
+	buf = append(buf, "POLYGON(("...)
+	for i, p := range b.ToRing() {
+		if i != 0 {
+			buf = append(buf, ',')
+		}
+		buf = appendCoord(buf, p, ' ')
+	}
+	buf = append(buf, "))"...)
+
+	return string(buf)
+}
+
 // Equal returns if two bounds are equal.
 func (b Bound) Equal(c Bound) bool {
 	return b.Min == c.Min && b.Max == c.Max
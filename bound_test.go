@@ -1,6 +1,8 @@
 package orb
 
 import (
+	"fmt"
+	"math/rand"
 	"testing"
 )
 
@@ -15,6 +17,11 @@ func TestBoundExtend(t *testing.T) {
 	if r := bound.Extend(Point{6, -1}); !r.Equal(answer) {
 		t.Errorf("extend incorrect: %v != %v", r, answer)
 	}
+
+	answer = Bound{Min: Point{2, 3}, Max: Point{2, 3}}
+	if r := emptyBound.Extend(Point{2, 3}); !r.Equal(answer) {
+		t.Errorf("extend empty incorrect: %v != %v", r, answer)
+	}
 }
 
 func TestBoundUnion(t *testing.T) {
@@ -29,6 +36,156 @@ func TestBoundUnion(t *testing.T) {
 	if b := b2.Union(b1); !b.Equal(expected) {
 		t.Errorf("union incorrect: %v != %v", b, expected)
 	}
+
+	b3 := Bound{Min: Point{2, 3}, Max: Point{4, 5}}
+	if b := emptyBound.Union(b3); !b.Equal(b3) {
+		t.Errorf("union with empty incorrect: %v != %v", b, b3)
+	}
+}
+
+func TestBoundUnion_Random(t *testing.T) {
+	cases := [][8]float64{
+		{0, 0, 1, 1, 2, 3, 4, 5},
+		{2, 3, 4, 5, 1, 1, -1, -1},
+		{-5, 2, 5, 3, 0, -10, 1, 10},
+	}
+
+	r := rand.New(rand.NewSource(42))
+	for i := 0; i < 1000; i++ {
+		// about a quarter of the bounds will be empty
+		var c [8]float64
+		for j := range c {
+			c[j] = 20*r.Float64() - 10
+		}
+		cases = append(cases, c)
+	}
+
+	for _, c := range cases {
+		b1 := Bound{Min: Point{c[0], c[1]}, Max: Point{c[2], c[3]}}
+		b2 := Bound{Min: Point{c[4], c[5]}, Max: Point{c[6], c[7]}}
+
+		u1 := b1.Union(b2)
+		u2 := b2.Union(b1)
+		if !sameBound(u1, u2) {
+			t.Errorf("not commutative: %v != %v", u1, u2)
+		}
+
+		for _, b := range []Bound{b1, b2} {
+			if v := b.Union(emptyBound); !sameBound(v, b) {
+				t.Errorf("empty not identity: %v != %v", v, b)
+			}
+
+			if v := emptyBound.Union(b); !sameBound(v, b) {
+				t.Errorf("empty not identity: %v != %v", v, b)
+			}
+
+			if !b.IsEmpty() && (!u1.Contains(b.Min) || !u1.Contains(b.Max)) {
+				t.Errorf("union %v does not contain %v", u1, b)
+			}
+		}
+	}
+}
+
+// sameBound returns true if the bounds are equal or both are empty.
+func sameBound(a, b Bound) bool {
+	if a.IsEmpty() || b.IsEmpty() {
+		return a.IsEmpty() && b.IsEmpty()
+	}
+
+	return a == b
+}
+
+func TestBoundOverlapRatio(t *testing.T) {
+	bound := Bound{Min: Point{0, 0}, Max: Point{2, 2}}
+
+	cases := []struct {
+		name   string
+		other  Bound
+		result float64
+	}{
+		{
+			name:   "identical",
+			other:  bound,
+			result: 1,
+		},
+		{
+			name:   "half overlap",
+			other:  Bound{Min: Point{1, 0}, Max: Point{3, 2}},
+			result: 1.0 / 3.0,
+		},
+		{
+			name:   "contained",
+			other:  Bound{Min: Point{0, 0}, Max: Point{1, 1}},
+			result: 0.25,
+		},
+		{
+			name:   "touching",
+			other:  Bound{Min: Point{2, 0}, Max: Point{3, 2}},
+			result: 0,
+		},
+		{
+			name:   "disjoint",
+			other:  Bound{Min: Point{5, 5}, Max: Point{6, 6}},
+			result: 0,
+		},
+		{
+			name:   "empty",
+			other:  emptyBound,
+			result: 0,
+		},
+		{
+			name:   "point",
+			other:  Point{1, 1}.Bound(),
+			result: 0,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			if v := bound.OverlapRatio(tc.other); v != tc.result {
+				t.Errorf("incorrect ratio: %v != %v", v, tc.result)
+			}
+
+			if v := tc.other.OverlapRatio(bound); v != tc.result {
+				t.Errorf("should be symmetric: %v != %v", v, tc.result)
+			}
+		})
+	}
+
+	// identical degenerate bounds
+	degenerate := []Bound{
+		Point{1, 1}.Bound(),
+		{Min: Point{0, 1}, Max: Point{2, 1}},
+		{Min: Point{1, 0}, Max: Point{1, 2}},
+	}
+
+	for _, b := range degenerate {
+		if v := b.OverlapRatio(b); v != 1 {
+			t.Errorf("identical bounds should return 1: %v: %v", b, v)
+		}
+	}
+
+	if v := emptyBound.OverlapRatio(emptyBound); v != 0 {
+		t.Errorf("empty bounds should return 0: %v", v)
+	}
+}
+
+func TestBoundPadPercent(t *testing.T) {
+	bound := Bound{Min: Point{0, 0}, Max: Point{10, 20}}
+
+	expected := Bound{Min: Point{-1, -4}, Max: Point{10, 23}}
+	if b := bound.PadPercent(0.1, 0.2, 0, 0.15); !b.Equal(expected) {
+		t.Errorf("incorrect bound: %v != %v", b, expected)
+	}
+
+	expected = Bound{Min: Point{1, 2}, Max: Point{9, 18}}
+	if b := bound.PadPercent(-0.1, -0.1, -0.1, -0.1); !b.Equal(expected) {
+		t.Errorf("negative values should shrink: %v != %v", b, expected)
+	}
+
+	if b := bound.PadPercent(0, 0, 0, 0); !b.Equal(bound) {
+		t.Errorf("zero padding should not change bound: %v", b)
+	}
 }
 
 func TestBoundContains(t *testing.T) {
@@ -226,6 +383,56 @@ func TestBoundCenter(t *testing.T) {
 	}
 }
 
+func TestBoundGrid(t *testing.T) {
+	bound := Bound{Min: Point{0, 0}, Max: Point{3, 2}}
+
+	grid := bound.Grid(3, 2)
+	if len(grid) != 2 {
+		t.Fatalf("incorrect number of rows: %d", len(grid))
+	}
+
+	for _, row := range grid {
+		if len(row) != 3 {
+			t.Fatalf("incorrect number of cols: %d", len(row))
+		}
+	}
+
+	if b := grid[0][0]; !b.Equal(Bound{Min: Point{0, 0}, Max: Point{1, 1}}) {
+		t.Errorf("incorrect first cell: %v", b)
+	}
+
+	if b := grid[1][0]; !b.Equal(Bound{Min: Point{0, 1}, Max: Point{1, 2}}) {
+		t.Errorf("incorrect first cell of second row: %v", b)
+	}
+
+	// cells share edges exactly
+	bound = Bound{Min: Point{0.1, 0.2}, Max: Point{0.7, 1.3}}
+	grid = bound.Grid(7, 3)
+	if b := grid[2][6]; b.Max != bound.Max {
+		t.Errorf("last cell should end at the bound: %v", b)
+	}
+
+	for r, row := range grid {
+		for c, b := range row {
+			if c > 0 && b.Min[0] != row[c-1].Max[0] {
+				t.Errorf("cell %d,%d does not share left edge: %v", r, c, b)
+			}
+
+			if r > 0 && b.Min[1] != grid[r-1][c].Max[1] {
+				t.Errorf("cell %d,%d does not share bottom edge: %v", r, c, b)
+			}
+		}
+	}
+
+	if g := bound.Grid(0, 2); g != nil {
+		t.Errorf("should be nil for no cols: %v", g)
+	}
+
+	if g := bound.Grid(2, -1); g != nil {
+		t.Errorf("should be nil for negative rows: %v", g)
+	}
+}
+
 func TestBoundIsZero(t *testing.T) {
 	bound := Bound{Min: Point{1, 2}, Max: Point{1, 2}}
 	if bound.IsZero() {
@@ -238,6 +445,30 @@ func TestBoundIsZero(t *testing.T) {
 	}
 }
 
+func TestBoundIsZeroWithin(t *testing.T) {
+	bound := Bound{Min: Point{-1e-12, 0}, Max: Point{1e-12, 2e-12}}
+	if bound.IsZero() {
+		t.Errorf("should not be exactly zero")
+	}
+
+	if !bound.IsZeroWithin(1e-9) {
+		t.Errorf("should be zero within epsilon")
+	}
+
+	if bound.IsZeroWithin(1e-12) {
+		t.Errorf("should not be zero within smaller epsilon")
+	}
+
+	if !(Bound{}).IsZeroWithin(0) {
+		t.Errorf("zero bound should be zero with zero epsilon")
+	}
+
+	bound = Bound{Min: Point{0, 0}, Max: Point{1, 1}}
+	if bound.IsZeroWithin(0.5) {
+		t.Errorf("should not be zero: %v", bound)
+	}
+}
+
 func TestBoundToRing(t *testing.T) {
 	bound := Bound{Min: Point{1, 1}, Max: Point{2, 2}}
 
@@ -253,3 +484,29 @@ func TestBoundToPolygon(t *testing.T) {
 		t.Errorf("orientation should be ccw")
 	}
 }
+
+func TestBoundString(t *testing.T) {
+	b := Bound{Min: Point{-1.5, 2}, Max: Point{3, 4.25}}
+	if v := b.String(); v != "[min=(-1.5,2), max=(3,4.25)]" {
+		t.Errorf("incorrect string: %v", v)
+	}
+
+	if v := fmt.Sprintf("%v", b); v != "[min=(-1.5,2), max=(3,4.25)]" {
+		t.Errorf("should implement fmt.Stringer: %v", v)
+	}
+
+	if v := emptyBound.String(); v != "EMPTY" {
+		t.Errorf("incorrect empty string: %v", v)
+	}
+}
+
+func TestBoundWKT(t *testing.T) {
+	b := Bound{Min: Point{0, 1}, Max: Point{2, 3.5}}
+	if v := b.WKT(); v != "POLYGON((0 1,2 1,2 3.5,0 3.5,0 1))" {
+		t.Errorf("incorrect wkt: %v", v)
+	}
+
+	if v := emptyBound.WKT(); v != "POLYGON EMPTY" {
+		t.Errorf("incorrect empty wkt: %v", v)
+	}
+}
@@ -0,0 +1,159 @@
+package orb
+
+import (
+	"math"
+)
+
+// Center returns a representative center point for the geometry.
+// This is the point itself for a Point, the average of the points for
+// a MultiPoint, the point halfway along a LineString and the area centroid
+// of a Ring or Polygon. Other geometries, or those with no length or area,
+// return the center of their bound. Distances and areas are computed
+// in the 2d plane.
+func Center(g Geometry) Point {
+	if g == nil {
+		return Point{}
+	}
+
+	switch g := g.(type) {
+	case Point:
+		return g
+	case MultiPoint:
+		if len(g) == 0 {
+			return Point{}
+		}
+
+		x, y := 0.0, 0.0
+		for _, p := range g {
+			x += p[0]
+			y += p[1]
+		}
+
+		num := float64(len(g))
+		return Point{x / num, y / num}
+	case LineString:
+		if len(g) == 0 {
+			return Point{}
+		}
+
+		if c, ok := lineStringMidpoint(g); ok {
+			return c
+		}
+	case Ring:
+		if c, ok := polygonCentroid(Polygon{g}); ok {
+			return c
+		}
+	case Polygon:
+		if c, ok := polygonCentroid(g); ok {
+			return c
+		}
+	}
+
+	b := g.Bound()
+	if b.IsEmpty() {
+		return Point{}
+	}
+
+	return b.Center()
+}
+
+// The helpers below are copies of the planar centroid and distance code.
+// The planar package imports orb so it can not be used here without an
+// import cycle. center_planar_test.go compares them with the planar package
+// so the copies stay in sync.
+
+// lineStringMidpoint returns the point halfway along the line string.
+// Returns false if the line string has no length.
+func lineStringMidpoint(ls LineString) (Point, bool) {
+	total := 0.0
+	for i := 0; i < len(ls)-1; i++ {
+		total += planarDistance(ls[i], ls[i+1])
+	}
+
+	if total == 0 {
+		return Point{}, false
+	}
+
+	half := total / 2
+	for i := 0; i < len(ls)-1; i++ {
+		d := planarDistance(ls[i], ls[i+1])
+		if d >= half {
+			r := half / d
+			return Point{
+				ls[i][0] + r*(ls[i+1][0]-ls[i][0]),
+				ls[i][1] + r*(ls[i+1][1]-ls[i][1]),
+			}, true
+		}
+
+		half -= d
+	}
+
+	return ls[len(ls)-1], true
+}
+
+// polygonCentroid returns the area centroid of the polygon, holes are
+// subtracted. Returns false if the polygon has no area.
+func polygonCentroid(p Polygon) (Point, bool) {
+	if len(p) == 0 {
+		return Point{}, false
+	}
+
+	centroid := Point{}
+	area := 0.0
+	for i, r := range p {
+		c, a := ringCentroidArea(r)
+		a = math.Abs(a)
+		if i > 0 {
+			a = -a
+		}
+
+		centroid[0] += c[0] * a
+		centroid[1] += c[1] * a
+		area += a
+	}
+
+	if area == 0 {
+		return Point{}, false
+	}
+
+	return Point{centroid[0] / area, centroid[1] / area}, true
+}
+
+// ringCentroidArea returns the centroid and signed area of the ring.
+func ringCentroidArea(r Ring) (Point, float64) {
+	if len(r) == 0 {
+		return Point{}, 0
+	}
+
+	centroid := Point{}
+	area := 0.0
+
+	// implicitly move everything to near the origin to help with roundoff
+	offsetX := r[0][0]
+	offsetY := r[0][1]
+	for i := 1; i < len(r)-1; i++ {
+		a := (r[i][0]-offsetX)*(r[i+1][1]-offsetY) -
+			(r[i+1][0]-offsetX)*(r[i][1]-offsetY)
+		area += a
+
+		centroid[0] += (r[i][0] + r[i+1][0] - 2*offsetX) * a
+		centroid[1] += (r[i][1] + r[i+1][1] - 2*offsetY) * a
+	}
+
+	if area == 0 {
+		return r[0], 0
+	}
+
+	area /= 2
+	centroid[0] /= 6 * area
+	centroid[1] /= 6 * area
+
+	centroid[0] += offsetX
+	centroid[1] += offsetY
+
+	return centroid, area
+}
+
+func planarDistance(p1, p2 Point) float64 {
+	return math.Hypot(p1[0]-p2[0], p1[1]-p2[1])
+}
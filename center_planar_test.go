@@ -0,0 +1,45 @@
+package orb_test
+
+import (
+	"math"
+	"math/rand"
+	"testing"
+
+	"github.com/paulmach/orb"
+	"github.com/paulmach/orb/planar"
+)
+
+func TestCenter_planar(t *testing.T) {
+	r := rand.New(rand.NewSource(42))
+	randomRing := func(cx, cy, size float64) orb.Ring {
+		ring := orb.Ring{}
+		for a := 0.0; a < 2*math.Pi; a += 0.2 + r.Float64() {
+			d := size * (0.5 + r.Float64()/2)
+			ring = append(ring, orb.Point{cx + d*math.Cos(a), cy + d*math.Sin(a)})
+		}
+
+		return append(ring, ring[0])
+	}
+
+	for i := 0; i < 100; i++ {
+		cx, cy := 100*r.Float64(), 100*r.Float64()
+		outer := randomRing(cx, cy, 10)
+		hole := randomRing(cx, cy, 2)
+		hole.Reverse()
+
+		for _, g := range []orb.Geometry{
+			outer,
+			orb.Polygon{outer},
+			orb.Polygon{outer, hole},
+		} {
+			expected, _ := planar.CentroidArea(g)
+			if c := orb.Center(g); planar.Distance(c, expected) > 1e-9 {
+				t.Errorf("%d: %T centroid does not match planar: %v != %v", i, g, c, expected)
+			}
+
+			if l := orb.Length(g); math.Abs(l-planar.Length(g)) > 1e-9 {
+				t.Errorf("%d: %T length does not match planar: %v != %v", i, g, l, planar.Length(g))
+			}
+		}
+	}
+}
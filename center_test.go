@@ -0,0 +1,69 @@
+package orb
+
+import (
+	"testing"
+)
+
+func TestCenter(t *testing.T) {
+	for _, g := range AllGeometries {
+		Center(g)
+	}
+
+	cases := []struct {
+		name     string
+		geom     Geometry
+		expected Point
+	}{
+		{
+			name:     "point",
+			geom:     Point{1, 2},
+			expected: Point{1, 2},
+		},
+		{
+			name:     "multi point",
+			geom:     MultiPoint{{0, 0}, {2, 0}, {1, 3}},
+			expected: Point{1, 1},
+		},
+		{
+			name:     "line string",
+			geom:     LineString{{0, 0}, {3, 0}, {3, 1}},
+			expected: Point{2, 0},
+		},
+		{
+			name:     "zero length line string",
+			geom:     LineString{{1, 1}, {1, 1}},
+			expected: Point{1, 1},
+		},
+		{
+			name:     "ring",
+			geom:     Ring{{0, 0}, {2, 0}, {2, 2}, {0, 2}, {0, 0}},
+			expected: Point{1, 1},
+		},
+		{
+			name: "polygon with hole",
+			geom: Polygon{
+				{{0, 0}, {4, 0}, {4, 2}, {0, 2}, {0, 0}},
+				{{2, 0}, {4, 0}, {4, 2}, {2, 2}, {2, 0}},
+			},
+			expected: Point{1, 1},
+		},
+		{
+			name:     "bound",
+			geom:     Bound{Min: Point{0, 0}, Max: Point{2, 4}},
+			expected: Point{1, 2},
+		},
+		{
+			name:     "multi line string",
+			geom:     MultiLineString{{{0, 0}, {1, 1}}, {{3, 3}, {4, 4}}},
+			expected: Point{2, 2},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			if v := Center(tc.geom); !v.Equal(tc.expected) {
+				t.Errorf("incorrect center: %v != %v", v, tc.expected)
+			}
+		})
+	}
+}
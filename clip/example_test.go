@@ -20,5 +20,5 @@ func ExampleGeometry() {
 
 	fmt.Println(clipped)
 	// Output:
-	// [[[0 10] [10 10] [10 0]] [[20 0] [20 10] [30 10]] [[30 20] [20 20] [20 30]] [[10 30] [10 20] [5 20] [0 20]]]
+	// MULTILINESTRING((0 10,10 10,10 0),(20 0,20 10,30 10),(30 20,20 20,20 30),(10 30,10 20,5 20,0 20))
 }
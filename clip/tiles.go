@@ -0,0 +1,47 @@
+package clip
+
+import (
+	"math"
+
+	"github.com/paulmach/orb"
+	"github.com/paulmach/orb/maptile"
+	"github.com/paulmach/orb/planar"
+)
+
+// PolygonToTiles clips the lon/lat polygon to every tile overlapping its bound
+// at the given zoom. Tiles where nothing of the polygon is left after clipping,
+// e.g. tiles inside a hole, are omitted. The input is not modified.
+func PolygonToTiles(p orb.Polygon, z maptile.Zoom) map[maptile.Tile]orb.Polygon {
+	result := make(map[maptile.Tile]orb.Polygon)
+	if len(p) == 0 || len(p[0]) == 0 {
+		return result
+	}
+
+	maptile.EachTile(p.Bound(), z, func(t maptile.Tile) bool {
+		clipped := Polygon(t.Bound(), p.Clone())
+		if len(clipped) == 0 || clipped[0].Orientation() == 0 {
+			return true
+		}
+
+		// holes that do not overlap the tile can clip to a degenerate ring
+		count := 1
+		for _, r := range clipped[1:] {
+			if r.Orientation() != 0 {
+				clipped[count] = r
+				count++
+			}
+		}
+
+		// a tile inside a hole clips to a hole covering the whole ring,
+		// the ring area is signed so take the absolute value for clockwise rings.
+		clipped = clipped[:count]
+		if planar.Area(clipped) <= 1e-9*math.Abs(planar.Area(clipped[0])) {
+			return true
+		}
+
+		result[t] = clipped
+		return true
+	})
+
+	return result
+}
@@ -0,0 +1,82 @@
+package clip
+
+import (
+	"math"
+	"testing"
+
+	"github.com/paulmach/orb"
+	"github.com/paulmach/orb/maptile"
+	"github.com/paulmach/orb/planar"
+)
+
+func TestPolygonToTiles(t *testing.T) {
+	p := orb.Polygon{
+		{{-100, -50}, {100, -50}, {100, 60}, {-100, 60}, {-100, -50}},
+		{{-1, -1}, {-1, 42}, {46, 42}, {46, -1}, {-1, -1}},
+	}
+	original := p.Clone()
+
+	tiles := PolygonToTiles(p, 3)
+	if len(tiles) == 0 {
+		t.Fatalf("should have tiles")
+	}
+
+	if !p.Equal(original) {
+		t.Errorf("should not modify the input")
+	}
+
+	// the tile is completely inside the hole
+	if _, ok := tiles[maptile.New(4, 3, 3)]; ok {
+		t.Errorf("tile inside the hole should be omitted")
+	}
+
+	area := 0.0
+	for tile, clipped := range tiles {
+		if tile.Z != 3 {
+			t.Errorf("incorrect zoom: %v", tile)
+		}
+
+		b := tile.Bound().Pad(1e-9)
+		for _, r := range clipped {
+			for _, pt := range r {
+				if !b.Contains(pt) {
+					t.Fatalf("point %v outside of tile %v", pt, tile)
+				}
+			}
+		}
+
+		area += planar.Area(clipped)
+	}
+
+	if e := planar.Area(p); math.Abs(area-e) > 1e-6 {
+		t.Errorf("incorrect total area: %v != %v", area, e)
+	}
+
+	if tiles := PolygonToTiles(orb.Polygon{}, 3); len(tiles) != 0 {
+		t.Errorf("empty polygon should have no tiles: %v", tiles)
+	}
+}
+
+func TestPolygonToTiles_clockwise(t *testing.T) {
+	p := orb.Polygon{
+		{{0, 0}, {20, 0}, {20, 20}, {0, 20}, {0, 0}},
+		{{1, 1}, {1, 19}, {19, 19}, {19, 1}, {1, 1}},
+	}
+
+	cw := p.Clone()
+	for _, r := range cw {
+		r.Reverse()
+	}
+
+	expected := PolygonToTiles(p, 6)
+	tiles := PolygonToTiles(cw, 6)
+	if len(tiles) != len(expected) {
+		t.Errorf("incorrect number of tiles: %d != %d", len(tiles), len(expected))
+	}
+
+	for tile := range tiles {
+		if _, ok := expected[tile]; !ok {
+			t.Errorf("tile inside the hole should be omitted: %v", tile)
+		}
+	}
+}
@@ -0,0 +1,94 @@
+package cluster
+
+import (
+	"github.com/paulmach/orb"
+	"github.com/paulmach/orb/planar"
+	"github.com/paulmach/orb/quadtree"
+)
+
+// DBSCAN groups the pointers in the tree using density based clustering.
+// A point with at least minPts points, including itself, within planar distance
+// eps is a core point. Clusters are the core points reachable from each other
+// plus the points within eps of them. Points in sparse areas are considered
+// noise and are not part of the result. Neighbors are found using the tree,
+// so the pointer values must be comparable, e.g. pointers or orb.Point values.
+func DBSCAN(tree *quadtree.Quadtree, eps float64, minPts int) [][]orb.Pointer {
+	if tree == nil || eps < 0 {
+		return nil
+	}
+
+	pointers := tree.InBound(nil, tree.Bound())
+	if len(pointers) == 0 {
+		return nil
+	}
+
+	// the same value can be in the tree more than once,
+	// e.g. duplicate orb.Point values.
+	indexes := make(map[orb.Pointer][]int, len(pointers))
+	for i, p := range pointers {
+		indexes[p] = append(indexes[p], i)
+	}
+
+	var buf []orb.Pointer
+	neighbors := func(i int) []int {
+		center := pointers[i].Point()
+		bound := orb.Bound{Min: center, Max: center}.Pad(eps)
+
+		buf = tree.InBoundMatching(buf, bound, func(p orb.Pointer) bool {
+			return planar.DistanceSquared(center, p.Point()) <= eps*eps
+		})
+
+		var result []int
+		seen := make(map[orb.Pointer]struct{}, len(buf))
+		for _, p := range buf {
+			if _, ok := seen[p]; ok {
+				continue
+			}
+			seen[p] = struct{}{}
+			result = append(result, indexes[p]...)
+		}
+
+		return result
+	}
+
+	const noCluster = -1
+	assigned := make([]int, len(pointers))
+	visited := make([]bool, len(pointers))
+	for i := range assigned {
+		assigned[i] = noCluster
+	}
+
+	var result [][]orb.Pointer
+	for i := range pointers {
+		if visited[i] {
+			continue
+		}
+		visited[i] = true
+
+		queue := neighbors(i)
+		if len(queue) < minPts {
+			// noise for now, it may be added to a cluster later as a border point
+			continue
+		}
+
+		c := len(result)
+		result = append(result, nil)
+
+		for j := 0; j < len(queue); j++ {
+			n := queue[j]
+			if !visited[n] {
+				visited[n] = true
+				if more := neighbors(n); len(more) >= minPts {
+					queue = append(queue, more...)
+				}
+			}
+
+			if assigned[n] == noCluster {
+				assigned[n] = c
+				result[c] = append(result[c], pointers[n])
+			}
+		}
+	}
+
+	return result
+}
@@ -0,0 +1,64 @@
+package cluster
+
+import (
+	"testing"
+
+	"github.com/paulmach/orb"
+	"github.com/paulmach/orb/quadtree"
+)
+
+func TestDBSCAN(t *testing.T) {
+	tree := quadtree.New(orb.Bound{Min: orb.Point{0, 0}, Max: orb.Point{100, 100}})
+
+	points := []orb.Point{
+		// dense cluster
+		{10, 10}, {11, 10}, {10, 11}, {11, 11},
+		// border point of the first cluster, not core itself
+		{12.5, 11},
+		// second cluster, includes duplicates
+		{50, 50}, {50, 50}, {51, 50},
+		// noise
+		{90, 90}, {30, 70},
+	}
+
+	for _, p := range points {
+		if err := tree.Add(p); err != nil {
+			t.Fatalf("add error: %v", err)
+		}
+	}
+
+	clusters := DBSCAN(tree, 1.5, 3)
+	if len(clusters) != 2 {
+		t.Fatalf("incorrect number of clusters: %v", clusters)
+	}
+
+	sizes := map[int]bool{len(clusters[0]): true, len(clusters[1]): true}
+	if !sizes[5] || !sizes[3] {
+		t.Errorf("incorrect cluster sizes: %v", clusters)
+	}
+
+	for _, c := range clusters {
+		for _, p := range c {
+			if p.Point().Equal(orb.Point{90, 90}) || p.Point().Equal(orb.Point{30, 70}) {
+				t.Errorf("noise should not be in a cluster: %v", c)
+			}
+		}
+	}
+
+	// everything is noise if minPts is too large
+	if v := DBSCAN(tree, 1.5, 10); len(v) != 0 {
+		t.Errorf("should have no clusters: %v", v)
+	}
+
+	// a large eps puts everything into one cluster
+	if v := DBSCAN(tree, 200, 3); len(v) != 1 || len(v[0]) != len(points) {
+		t.Errorf("should have one cluster: %v", v)
+	}
+}
+
+func TestDBSCAN_empty(t *testing.T) {
+	tree := quadtree.New(orb.Bound{Max: orb.Point{1, 1}})
+	if v := DBSCAN(tree, 1, 1); v != nil {
+		t.Errorf("empty tree should return nil: %v", v)
+	}
+}
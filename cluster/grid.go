@@ -0,0 +1,68 @@
+// Package cluster has helpers for grouping nearby points together,
+// e.g. to declutter markers on a map.
+package cluster
+
+import (
+	"math"
+
+	"github.com/paulmach/orb"
+)
+
+// A Cluster is a group of points from the input.
+type Cluster struct {
+	// Center is the centroid, the average, of the member points.
+	Center orb.Point
+
+	Count int
+
+	// Members are the indexes of the points in the input.
+	Members []int
+}
+
+// Grid groups the points into square cells of the given size aligned
+// to the origin. One cluster is returned for every non-empty cell, in the
+// order the cells are first seen in the input. A non-positive cell size
+// will return nil.
+func Grid(mp orb.MultiPoint, cellSize float64) []Cluster {
+	if cellSize <= 0 || len(mp) == 0 {
+		return nil
+	}
+
+	type cell struct {
+		x, y int64
+	}
+
+	var (
+		result []Cluster
+		sums   []orb.Point
+	)
+
+	lookup := make(map[cell]int)
+	for i, p := range mp {
+		c := cell{
+			x: int64(math.Floor(p[0] / cellSize)),
+			y: int64(math.Floor(p[1] / cellSize)),
+		}
+
+		index, ok := lookup[c]
+		if !ok {
+			index = len(result)
+			lookup[c] = index
+
+			result = append(result, Cluster{})
+			sums = append(sums, orb.Point{})
+		}
+
+		result[index].Count++
+		result[index].Members = append(result[index].Members, i)
+		sums[index][0] += p[0]
+		sums[index][1] += p[1]
+	}
+
+	for i := range result {
+		n := float64(result[i].Count)
+		result[i].Center = orb.Point{sums[i][0] / n, sums[i][1] / n}
+	}
+
+	return result
+}
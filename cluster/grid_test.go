@@ -0,0 +1,49 @@
+package cluster
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/paulmach/orb"
+)
+
+func TestGrid(t *testing.T) {
+	mp := orb.MultiPoint{
+		{0.5, 0.5},
+		{5.5, 5.5},
+		{1.5, 1.5},
+		{-0.5, 0.5},
+		{6.5, 6.5},
+	}
+
+	clusters := Grid(mp, 2)
+	expected := []Cluster{
+		{Center: orb.Point{1, 1}, Count: 2, Members: []int{0, 2}},
+		{Center: orb.Point{5.5, 5.5}, Count: 1, Members: []int{1}},
+		{Center: orb.Point{-0.5, 0.5}, Count: 1, Members: []int{3}},
+		{Center: orb.Point{6.5, 6.5}, Count: 1, Members: []int{4}},
+	}
+
+	if !reflect.DeepEqual(clusters, expected) {
+		t.Errorf("incorrect clusters: %v", clusters)
+	}
+
+	clusters = Grid(mp, 10)
+	if len(clusters) != 2 {
+		t.Fatalf("incorrect number of clusters: %v", clusters)
+	}
+
+	if c := clusters[0]; c.Count != 4 || !c.Center.Equal(orb.Point{3.5, 3.5}) {
+		t.Errorf("incorrect cluster: %v", c)
+	}
+}
+
+func TestGrid_edgeCases(t *testing.T) {
+	if v := Grid(nil, 1); v != nil {
+		t.Errorf("empty input should return nil: %v", v)
+	}
+
+	if v := Grid(orb.MultiPoint{{1, 1}}, 0); v != nil {
+		t.Errorf("zero cell size should return nil: %v", v)
+	}
+}
@@ -0,0 +1,48 @@
+// Package encoding contains helpers that work across the
+// geometry encodings implemented in the sub-packages.
+package encoding
+
+import (
+	"encoding/json"
+
+	"github.com/paulmach/orb"
+	"github.com/paulmach/orb/encoding/wkb"
+	"github.com/paulmach/orb/geojson"
+)
+
+// RoundTrip passes the geometry through the WKB and GeoJSON encodings,
+// WKB -> orb -> GeoJSON -> orb -> WKB -> orb, and returns the result.
+// The result should equal the input, except for types that are normalized
+// by the encodings, e.g. a Ring or Bound will be returned as a Polygon.
+// Both encodings are lossless for float64 coordinates, WKB stores the bits
+// and GeoJSON uses the shortest decimal that parses back to the same value,
+// so the coordinates are expected to be exactly equal, not within a tolerance.
+// It is used to verify the encodings agree with each other.
+func RoundTrip(g orb.Geometry) (orb.Geometry, error) {
+	data, err := wkb.Marshal(g)
+	if err != nil {
+		return nil, err
+	}
+
+	g, err = wkb.Unmarshal(data)
+	if err != nil {
+		return nil, err
+	}
+
+	data, err = json.Marshal(geojson.NewGeometry(g))
+	if err != nil {
+		return nil, err
+	}
+
+	jg, err := geojson.UnmarshalGeometry(data)
+	if err != nil {
+		return nil, err
+	}
+
+	data, err = wkb.Marshal(jg.Geometry())
+	if err != nil {
+		return nil, err
+	}
+
+	return wkb.Unmarshal(data)
+}
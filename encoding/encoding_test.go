@@ -0,0 +1,128 @@
+package encoding
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/paulmach/orb"
+)
+
+func TestRoundTrip(t *testing.T) {
+	cases := []struct {
+		name     string
+		geom     orb.Geometry
+		expected orb.Geometry
+	}{
+		{
+			name:     "point",
+			geom:     orb.Point{1.5, -2.25},
+			expected: orb.Point{1.5, -2.25},
+		},
+		{
+			name:     "ring",
+			geom:     orb.Ring{{0, 0}, {1, 0}, {1, 1}, {0, 0}},
+			expected: orb.Polygon{{{0, 0}, {1, 0}, {1, 1}, {0, 0}}},
+		},
+		{
+			name:     "bound",
+			geom:     orb.Bound{Min: orb.Point{0, 0}, Max: orb.Point{1, 2}},
+			expected: orb.Bound{Min: orb.Point{0, 0}, Max: orb.Point{1, 2}}.ToPolygon(),
+		},
+		{
+			name:     "collection",
+			geom:     orb.Collection{orb.Point{1, 2}, orb.LineString{{1, 2}, {3, 4}}},
+			expected: orb.Collection{orb.Point{1, 2}, orb.LineString{{1, 2}, {3, 4}}},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			g, err := RoundTrip(tc.geom)
+			if err != nil {
+				t.Fatalf("round trip error: %v", err)
+			}
+
+			if !orb.Equal(g, tc.expected) {
+				t.Errorf("incorrect geometry: %v != %v", g, tc.expected)
+			}
+		})
+	}
+}
+
+func TestRoundTrip_Random(t *testing.T) {
+	for seed := int64(0); seed < 500; seed++ {
+		g := randomGeometry(rand.New(rand.NewSource(seed)), 2)
+
+		result, err := RoundTrip(g)
+		if err != nil {
+			t.Fatalf("round trip error for seed %d: %v", seed, err)
+		}
+
+		// the encodings are lossless so no tolerance is needed
+		if !orb.Equal(g, result) {
+			t.Errorf("geometry changed for seed %d: %v != %v", seed, result, g)
+		}
+	}
+}
+
+// randomGeometry returns a random non-empty geometry of one of the types
+// that is not normalized by the encodings.
+func randomGeometry(r *rand.Rand, depth int) orb.Geometry {
+	n := 7
+	if depth <= 0 {
+		n = 6
+	}
+
+	switch r.Intn(n) {
+	case 0:
+		return randomPoint(r)
+	case 1:
+		return orb.MultiPoint(randomPoints(r, 1))
+	case 2:
+		return orb.LineString(randomPoints(r, 2))
+	case 3:
+		mls := orb.MultiLineString{}
+		for i := 0; i < 1+r.Intn(3); i++ {
+			mls = append(mls, orb.LineString(randomPoints(r, 2)))
+		}
+		return mls
+	case 4:
+		return randomPolygon(r)
+	case 5:
+		mp := orb.MultiPolygon{}
+		for i := 0; i < 1+r.Intn(3); i++ {
+			mp = append(mp, randomPolygon(r))
+		}
+		return mp
+	default:
+		c := orb.Collection{}
+		for i := 0; i < 1+r.Intn(3); i++ {
+			c = append(c, randomGeometry(r, depth-1))
+		}
+		return c
+	}
+}
+
+func randomPolygon(r *rand.Rand) orb.Polygon {
+	p := orb.Polygon{}
+	for i := 0; i < 1+r.Intn(3); i++ {
+		ring := orb.Ring(randomPoints(r, 3))
+		ring = append(ring, ring[0])
+		p = append(p, ring)
+	}
+
+	return p
+}
+
+func randomPoints(r *rand.Rand, min int) []orb.Point {
+	ps := make([]orb.Point, 0, min+5)
+	for i := 0; i < min+r.Intn(5); i++ {
+		ps = append(ps, randomPoint(r))
+	}
+
+	return ps
+}
+
+func randomPoint(r *rand.Rand) orb.Point {
+	return orb.Point{r.Float64()*360 - 180, r.Float64()*180 - 90}
+}
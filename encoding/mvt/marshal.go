@@ -3,6 +3,7 @@ package mvt
 import (
 	"bytes"
 	"compress/gzip"
+	"encoding/json"
 	"fmt"
 	"strconv"
 
@@ -137,6 +138,11 @@ func convertID(id interface{}) *uint64 {
 		if err == nil {
 			return convertIntID(i)
 		}
+	case json.Number:
+		v, err := strconv.ParseUint(string(id), 10, 64)
+		if err == nil {
+			return &v
+		}
 	}
 
 	return nil
@@ -1,7 +1,7 @@
 package wkb
 
 import (
-	"errors"
+	"fmt"
 	"io"
 	"math"
 
@@ -64,7 +64,7 @@ func (e *Encoder) writeLineString(ls orb.LineString) error {
 
 func unmarshalMultiLineString(order byteOrder, data []byte) (orb.MultiLineString, error) {
 	if len(data) < 4 {
-		return nil, ErrNotWKB
+		return nil, ErrNotEnoughData
 	}
 	num := unmarshalUint32(order, data)
 	data = data[4:]
@@ -109,7 +109,7 @@ func readMultiLineString(r io.Reader, order byteOrder, buf []byte) (orb.MultiLin
 		}
 
 		if typ != lineStringType {
-			return nil, errors.New("expect multilines to contains lines, did not find a line")
+			return nil, fmt.Errorf("%w: expect multilines to contains lines, did not find a line", ErrIncorrectGeometry)
 		}
 
 		ls, err := readLineString(r, lOrder, buf)
@@ -2,7 +2,7 @@ package wkb
 
 import (
 	"encoding/binary"
-	"errors"
+	"fmt"
 	"io"
 	"math"
 
@@ -11,13 +11,13 @@ import (
 
 func unmarshalPoints(order byteOrder, data []byte) ([]orb.Point, error) {
 	if len(data) < 4 {
-		return nil, ErrNotWKB
+		return nil, ErrNotEnoughData
 	}
 	num := unmarshalUint32(order, data)
 	data = data[4:]
 
 	if len(data) < int(num*16) {
-		return nil, ErrNotWKB
+		return nil, ErrNotEnoughData
 	}
 
 	alloc := num
@@ -46,7 +46,7 @@ func unmarshalPoints(order byteOrder, data []byte) ([]orb.Point, error) {
 
 func unmarshalPoint(order byteOrder, buf []byte) (orb.Point, error) {
 	if len(buf) < 16 {
-		return orb.Point{}, ErrNotWKB
+		return orb.Point{}, ErrNotEnoughData
 	}
 
 	var p orb.Point
@@ -93,7 +93,7 @@ func (e *Encoder) writePoint(p orb.Point) error {
 
 func unmarshalMultiPoint(order byteOrder, data []byte) (orb.MultiPoint, error) {
 	if len(data) < 4 {
-		return nil, ErrNotWKB
+		return nil, ErrNotEnoughData
 	}
 	num := unmarshalUint32(order, data)
 	data = data[4:]
@@ -138,7 +138,7 @@ func readMultiPoint(r io.Reader, order byteOrder, buf []byte) (orb.MultiPoint, e
 		}
 
 		if typ != pointType {
-			return nil, errors.New("expect multipoint to contains points, did not find a point")
+			return nil, fmt.Errorf("%w: expect multipoint to contains points, did not find a point", ErrIncorrectGeometry)
 		}
 
 		p, err := readPoint(r, pOrder, buf)
@@ -1,7 +1,7 @@
 package wkb
 
 import (
-	"errors"
+	"fmt"
 	"io"
 	"math"
 
@@ -10,7 +10,7 @@ import (
 
 func unmarshalPolygon(order byteOrder, data []byte) (orb.Polygon, error) {
 	if len(data) < 4 {
-		return nil, ErrNotWKB
+		return nil, ErrNotEnoughData
 	}
 	num := unmarshalUint32(order, data)
 	data = data[4:]
@@ -87,7 +87,7 @@ func (e *Encoder) writePolygon(p orb.Polygon) error {
 
 func unmarshalMultiPolygon(order byteOrder, data []byte) (orb.MultiPolygon, error) {
 	if len(data) < 4 {
-		return nil, ErrNotWKB
+		return nil, ErrNotEnoughData
 	}
 	num := unmarshalUint32(order, data)
 	data = data[4:]
@@ -137,7 +137,7 @@ func readMultiPolygon(r io.Reader, order byteOrder, buf []byte) (orb.MultiPolygo
 		}
 
 		if typ != polygonType {
-			return nil, errors.New("expect multipolygons to contains polygons, did not find a polygon")
+			return nil, fmt.Errorf("%w: expect multipolygons to contains polygons, did not find a polygon", ErrIncorrectGeometry)
 		}
 
 		p, err := readPolygon(r, pOrder, buf)
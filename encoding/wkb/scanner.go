@@ -26,6 +26,12 @@ var (
 	// ErrNotWKB is returned when unmarshalling WKB and the data is not valid.
 	ErrNotWKB = errors.New("wkb: invalid data")
 
+	// ErrNotEnoughData is returned when unmarshalling WKB and the data ends
+	// before the geometry is complete. It wraps ErrNotWKB so errors.Is(err, ErrNotWKB)
+	// is also true, but err == ErrNotWKB, which matched truncated data before this
+	// error was added, no longer does.
+	ErrNotEnoughData = fmt.Errorf("%w: not enough data", ErrNotWKB)
+
 	// ErrIncorrectGeometry is returned when unmarshalling WKB data into the wrong type.
 	// For example, unmarshaling linestring data into a point.
 	ErrIncorrectGeometry = errors.New("wkb: incorrect geometry")
@@ -108,7 +114,7 @@ func (s *GeometryScanner) Scan(d interface{}) error {
 	if len(data) > 2 && data[0] == byte('\\') && data[1] == byte('x') {
 		n, err := hex.Decode(data, data[2:])
 		if err != nil {
-			return fmt.Errorf("thought the data was hex, but it is not: %v", err)
+			return fmt.Errorf("%w: thought the data was hex, but it is not: %v", ErrNotWKB, err)
 		}
 		data = data[:n]
 	}
@@ -349,7 +355,7 @@ func scanMultiPolygon(data []byte) (orb.MultiPolygon, error) {
 func scanCollection(data []byte) (orb.Collection, error) {
 	m, err := NewDecoder(bytes.NewReader(data)).Decode()
 	if err == io.EOF || err == io.ErrUnexpectedEOF {
-		return nil, ErrNotWKB
+		return nil, ErrNotEnoughData
 	}
 
 	if err != nil {
@@ -150,9 +150,9 @@ func TestScanPoint_Errors(t *testing.T) {
 			err:  ErrUnsupportedDataType,
 		},
 		{
-			name: "not wkb",
+			name: "not enough data",
 			data: []byte{0, 0, 0, 0, 1, 192, 94, 157, 24, 227, 60, 152, 15, 64, 66, 222, 128, 39},
-			err:  ErrNotWKB,
+			err:  ErrNotEnoughData,
 		},
 		{
 			name: "invalid first byte",
@@ -257,9 +257,9 @@ func TestScanMultiPoint_Errors(t *testing.T) {
 			err:  ErrUnsupportedDataType,
 		},
 		{
-			name: "not wkb",
+			name: "not enough data",
 			data: []byte{0, 0, 0, 0, 1, 192, 94},
-			err:  ErrNotWKB,
+			err:  ErrNotEnoughData,
 		},
 	}
 
@@ -349,9 +349,9 @@ func TestScanLineString_Errors(t *testing.T) {
 			err:  ErrUnsupportedDataType,
 		},
 		{
-			name: "not wkb",
+			name: "not enough data",
 			data: []byte{0, 0, 0, 0, 2, 192, 94},
-			err:  ErrNotWKB,
+			err:  ErrNotEnoughData,
 		},
 	}
 
@@ -446,9 +446,9 @@ func TestScanMultiLineString_Errors(t *testing.T) {
 			err:  ErrUnsupportedDataType,
 		},
 		{
-			name: "not wkb",
+			name: "not enough data",
 			data: []byte{0, 0, 0, 0, 5, 192, 94},
-			err:  ErrNotWKB,
+			err:  ErrNotEnoughData,
 		},
 	}
 
@@ -528,9 +528,9 @@ func TestScanRing_Errors(t *testing.T) {
 			err:  ErrUnsupportedDataType,
 		},
 		{
-			name: "not wkb",
+			name: "not enough data",
 			data: []byte{0, 0, 0, 0, 1, 192, 94},
-			err:  ErrNotWKB,
+			err:  ErrNotEnoughData,
 		},
 	}
 
@@ -620,9 +620,9 @@ func TestScanPolygon_Errors(t *testing.T) {
 			err:  ErrUnsupportedDataType,
 		},
 		{
-			name: "not wkb",
+			name: "not enough data",
 			data: []byte{0, 0, 0, 0, 3, 192, 94},
-			err:  ErrNotWKB,
+			err:  ErrNotEnoughData,
 		},
 	}
 
@@ -717,9 +717,9 @@ func TestScanMultiPolygon_Errors(t *testing.T) {
 			err:  ErrUnsupportedDataType,
 		},
 		{
-			name: "not wkb",
+			name: "not enough data",
 			data: []byte{0, 0, 0, 0, 6, 192, 94},
-			err:  ErrNotWKB,
+			err:  ErrNotEnoughData,
 		},
 	}
 
@@ -799,9 +799,9 @@ func TestScanCollection_Errors(t *testing.T) {
 			err:  ErrUnsupportedDataType,
 		},
 		{
-			name: "not wkb",
+			name: "not enough data",
 			data: []byte{0, 0, 0, 0, 7, 192, 94},
-			err:  ErrNotWKB,
+			err:  ErrNotEnoughData,
 		},
 	}
 
@@ -916,9 +916,9 @@ func TestScanBound_Errors(t *testing.T) {
 			err:  ErrUnsupportedDataType,
 		},
 		{
-			name: "not wkb",
+			name: "not enough data",
 			data: []byte{0, 0, 0, 0, 1, 192, 94},
-			err:  ErrNotWKB,
+			err:  ErrNotEnoughData,
 		},
 	}
 
@@ -135,24 +135,27 @@ func (e *Encoder) Encode(geom orb.Geometry) error {
 		geom = orb.Polygon{g}
 	case orb.Bound:
 		geom = g.ToPolygon()
+	case orb.Point:
+	default:
+		return ErrUnsupportedGeometry
+	}
+
+	if e.buf == nil {
+		e.buf = make([]byte, 16)
 	}
 
-	var b []byte
+	// reuse the scratch buffer so streaming many geometries
+	// through one encoder does not allocate per call.
+	e.buf[0] = 0
 	if e.order == binary.LittleEndian {
-		b = []byte{1}
-	} else {
-		b = []byte{0}
+		e.buf[0] = 1
 	}
 
-	_, err := e.w.Write(b)
+	_, err := e.w.Write(e.buf[:1])
 	if err != nil {
 		return err
 	}
 
-	if e.buf == nil {
-		e.buf = make([]byte, 16)
-	}
-
 	switch g := geom.(type) {
 	case orb.Point:
 		return e.writePoint(g)
@@ -201,7 +204,7 @@ func Unmarshal(data []byte) (orb.Geometry, error) {
 	case geometryCollectionType:
 		g, err := NewDecoder(bytes.NewReader(data)).Decode()
 		if err == io.EOF || err == io.ErrUnexpectedEOF {
-			return nil, ErrNotWKB
+			return nil, ErrNotEnoughData
 		}
 
 		return g, err
@@ -218,6 +221,9 @@ func NewDecoder(r io.Reader) *Decoder {
 }
 
 // Decode will decode the next geometry off of the stream.
+// Each geometry can have its own byte order. io.EOF is returned
+// if the stream is exhausted. If the stream ends in the middle of
+// a geometry io.ErrUnexpectedEOF is returned.
 func (d *Decoder) Decode() (orb.Geometry, error) {
 	buf := make([]byte, 8)
 	order, typ, err := readByteOrderType(d.r, buf)
@@ -225,6 +231,15 @@ func (d *Decoder) Decode() (orb.Geometry, error) {
 		return nil, err
 	}
 
+	g, err := d.decode(order, typ, buf)
+	if err == io.EOF {
+		return nil, io.ErrUnexpectedEOF
+	}
+
+	return g, err
+}
+
+func (d *Decoder) decode(order byteOrder, typ uint32, buf []byte) (orb.Geometry, error) {
 	switch typ {
 	case pointType:
 		return readPoint(d.r, order, buf)
@@ -246,8 +261,9 @@ func (d *Decoder) Decode() (orb.Geometry, error) {
 }
 
 func readByteOrderType(r io.Reader, buf []byte) (byteOrder, uint32, error) {
-	// the byte order is the first byte
-	if _, err := r.Read(buf[:1]); err != nil {
+	// the byte order is the first byte, io.ReadFull will return io.EOF
+	// only if there is nothing left to read.
+	if _, err := io.ReadFull(r, buf[:1]); err != nil {
 		return 0, 0, err
 	}
 
@@ -262,7 +278,9 @@ func readByteOrderType(r io.Reader, buf []byte) (byteOrder, uint32, error) {
 
 	// the type which is 4 bytes
 	typ, err := readUint32(r, order, buf[:4])
-	if err != nil {
+	if err == io.EOF {
+		return 0, 0, io.ErrUnexpectedEOF
+	} else if err != nil {
 		return 0, 0, err
 	}
 
@@ -299,7 +317,7 @@ func unmarshalByteOrderType(buf []byte) (byteOrder, uint32, []byte, error) {
 
 func byteOrderType(buf []byte) (byteOrder, uint32, error) {
 	if len(buf) < 6 {
-		return 0, 0, ErrNotWKB
+		return 0, 0, ErrNotEnoughData
 	}
 
 	var order byteOrder
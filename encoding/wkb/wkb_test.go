@@ -3,8 +3,11 @@ package wkb
 import (
 	"bytes"
 	"encoding/binary"
+	"errors"
+	"io"
 	"io/ioutil"
 	"testing"
+	"testing/iotest"
 
 	"github.com/paulmach/orb"
 )
@@ -21,6 +24,160 @@ func TestMustMarshal(t *testing.T) {
 	}
 }
 
+func TestEncoder_stream(t *testing.T) {
+	geoms := []orb.Geometry{
+		orb.Point{1, 2},
+		orb.LineString{{1, 2}, {3, 4}},
+		orb.Polygon{{{0, 0}, {1, 0}, {1, 1}, {0, 0}}},
+		orb.Collection{orb.Point{5, 6}, orb.MultiPoint{{7, 8}}},
+	}
+
+	for _, order := range []binary.ByteOrder{binary.LittleEndian, binary.BigEndian} {
+		buf := bytes.NewBuffer(nil)
+		e := NewEncoder(buf)
+		e.SetByteOrder(order)
+
+		var expected []byte
+		for i, g := range geoms {
+			if err := e.Encode(g); err != nil {
+				t.Fatalf("%v %d: encode error: %v", order, i, err)
+			}
+			expected = append(expected, MustMarshal(g, order)...)
+		}
+
+		if !bytes.Equal(buf.Bytes(), expected) {
+			t.Errorf("%v: encoder output does not match marshal", order)
+		}
+
+		d := NewDecoder(buf)
+		for i, g := range geoms {
+			result, err := d.Decode()
+			if err != nil {
+				t.Fatalf("%v %d: decode error: %v", order, i, err)
+			}
+
+			if !orb.Equal(result, g) {
+				t.Errorf("%v %d: incorrect geometry: %v != %v", order, i, result, g)
+			}
+		}
+	}
+}
+
+func TestEncoder_unsupported(t *testing.T) {
+	buf := bytes.NewBuffer(nil)
+	err := NewEncoder(buf).Encode(unsupportedGeometry{})
+	if err != ErrUnsupportedGeometry {
+		t.Errorf("incorrect error: %v", err)
+	}
+
+	if buf.Len() != 0 {
+		t.Errorf("should not write any data: %v", buf.Bytes())
+	}
+}
+
+type unsupportedGeometry struct{ orb.Point }
+
+func TestDecoder_stream(t *testing.T) {
+	geoms := []orb.Geometry{
+		orb.Point{1, 2},
+		orb.LineString{{1, 2}, {3, 4}},
+		orb.Polygon{{{0, 0}, {1, 0}, {1, 1}, {0, 0}}},
+		orb.Collection{orb.Point{5, 6}, orb.MultiPoint{{7, 8}}},
+	}
+
+	// concatenate the geometries using alternating byte orders
+	var data []byte
+	for i, g := range geoms {
+		var order binary.ByteOrder = binary.LittleEndian
+		if i%2 == 1 {
+			order = binary.BigEndian
+		}
+		data = append(data, MustMarshal(g, order)...)
+	}
+
+	// one byte at a time reader to simulate a slow stream
+	d := NewDecoder(iotest.OneByteReader(bytes.NewReader(data)))
+	for i, expected := range geoms {
+		g, err := d.Decode()
+		if err != nil {
+			t.Fatalf("%d: decode error: %v", i, err)
+		}
+
+		if !orb.Equal(g, expected) {
+			t.Errorf("%d: incorrect geometry: %v != %v", i, g, expected)
+		}
+	}
+
+	if _, err := d.Decode(); err != io.EOF {
+		t.Errorf("should return io.EOF when exhausted: %v", err)
+	}
+
+	// readers that return the data and io.EOF together
+	d = NewDecoder(iotest.DataErrReader(bytes.NewReader(data)))
+	for i := range geoms {
+		if _, err := d.Decode(); err != nil {
+			t.Fatalf("%d: decode error: %v", i, err)
+		}
+	}
+
+	if _, err := d.Decode(); err != io.EOF {
+		t.Errorf("should return io.EOF when exhausted: %v", err)
+	}
+}
+
+func TestDecoder_truncated(t *testing.T) {
+	data := MustMarshal(orb.LineString{{1, 2}, {3, 4}})
+
+	for _, l := range []int{1, 3, 9, len(data) - 1} {
+		_, err := NewDecoder(bytes.NewReader(data[:l])).Decode()
+		if err != io.ErrUnexpectedEOF {
+			t.Errorf("length %d: should return unexpected eof: %v", l, err)
+		}
+	}
+}
+
+func TestUnmarshal_errors(t *testing.T) {
+	data := MustMarshal(orb.Collection{orb.LineString{{1, 2}, {3, 4}}})
+
+	for _, l := range []int{3, 9, 14, len(data) - 1} {
+		_, err := Unmarshal(data[:l])
+		if !errors.Is(err, ErrNotEnoughData) {
+			t.Errorf("length %d: should return not enough data: %v", l, err)
+		}
+
+		if !errors.Is(err, ErrNotWKB) {
+			t.Errorf("length %d: should also be not wkb: %v", l, err)
+		}
+	}
+
+	// a line string type inside a multi point
+	data = MustMarshal(orb.MultiPoint{{1, 2}})
+	data[10] = 2
+	if _, err := Unmarshal(data); !errors.Is(err, ErrIncorrectGeometry) {
+		t.Errorf("should return incorrect geometry: %v", err)
+	}
+
+	if _, err := NewDecoder(bytes.NewReader(data)).Decode(); !errors.Is(err, ErrIncorrectGeometry) {
+		t.Errorf("decoder should return incorrect geometry: %v", err)
+	}
+
+	// not wkb at all, the error is unchanged from before ErrNotEnoughData
+	data = bytes.Repeat([]byte{3}, 21)
+	if _, err := Unmarshal(data); err != ErrNotWKB {
+		t.Errorf("should return not wkb: %v", err)
+	}
+
+	if _, err := NewDecoder(bytes.NewReader(data)).Decode(); err != ErrNotWKB {
+		t.Errorf("decoder should return not wkb: %v", err)
+	}
+
+	data = MustMarshal(orb.Point{1, 2})
+	data[1] = 50
+	if _, err := Unmarshal(data); !errors.Is(err, ErrUnsupportedGeometry) {
+		t.Errorf("should return unsupported geometry: %v", err)
+	}
+}
+
 func BenchmarkEncode_Point(b *testing.B) {
 	g := orb.Point{1, 2}
 	e := NewEncoder(ioutil.Discard)
@@ -8,39 +8,82 @@ import (
 	"github.com/paulmach/orb"
 )
 
+var (
+	// ErrNotWKT is returned when unmarshalling WKT and the data is not valid.
+	ErrNotWKT = errors.New("wkt: invalid data")
+
+	// ErrIncorrectGeometry is returned when unmarshalling WKT data into the wrong type.
+	// For example, unmarshaling linestring data into a point.
+	ErrIncorrectGeometry = errors.New("wkt: incorrect geometry")
+
+	// ErrUnsupportedGeometry is returned when geometry type is not supported by this lib.
+	ErrUnsupportedGeometry = errors.New("wkt: unsupported geometry")
+)
+
 var (
 	errEmptyGeometry              = errors.New("empty geometry")
-	errUnMarshalPoint             = errors.New("unmarshal point error")
-	errUnMarshalMultiPoint        = errors.New("unmarshal multipoint error")
-	errUnMarshaLineString         = errors.New("unmarshal linestring error")
-	errUnMarshaMultiLineString    = errors.New("unmarshal multilinestring error")
-	errUnMarshaPolygon            = errors.New("unmarshal polygon error")
-	errUnMarshaMultiPolygon       = errors.New("unmarshal multipolygon error")
-	errUnMarshaGeometryCollection = errors.New("unmarshal collection error")
-
-	errConvertToPoint              = errors.New("convert to point error")
-	errConvertToMultiPoint         = errors.New("convert to multi point error")
-	errConvertToLineString         = errors.New("convert to line string error")
-	errConvertToMultiLineString    = errors.New("convert to multi line string error")
-	errConvertToPolygon            = errors.New("convert to polygon error")
-	errConvertToMultiPolygon       = errors.New("convert to multi polygon error")
-	errConvertToGeometryCollection = errors.New("convert to geometry collection error")
+	errUnMarshalPoint             = kindError(ErrNotWKT, "unmarshal point error")
+	errUnMarshalMultiPoint        = kindError(ErrNotWKT, "unmarshal multipoint error")
+	errUnMarshaLineString         = kindError(ErrNotWKT, "unmarshal linestring error")
+	errUnMarshaMultiLineString    = kindError(ErrNotWKT, "unmarshal multilinestring error")
+	errUnMarshaPolygon            = kindError(ErrNotWKT, "unmarshal polygon error")
+	errUnMarshaMultiPolygon       = kindError(ErrNotWKT, "unmarshal multipolygon error")
+	errUnMarshaGeometryCollection = kindError(ErrNotWKT, "unmarshal collection error")
+
+	errConvertToPoint              = kindError(ErrIncorrectGeometry, "convert to point error")
+	errConvertToMultiPoint         = kindError(ErrIncorrectGeometry, "convert to multi point error")
+	errConvertToLineString         = kindError(ErrIncorrectGeometry, "convert to line string error")
+	errConvertToMultiLineString    = kindError(ErrIncorrectGeometry, "convert to multi line string error")
+	errConvertToPolygon            = kindError(ErrIncorrectGeometry, "convert to polygon error")
+	errConvertToMultiPolygon       = kindError(ErrIncorrectGeometry, "convert to multi polygon error")
+	errConvertToGeometryCollection = kindError(ErrIncorrectGeometry, "convert to geometry collection error")
 )
 
-// errWrap errWarp
+// wrappedError is an error with its own message that matches
+// all of the errors it was created from with errors.Is.
+type wrappedError struct {
+	msg  string
+	errs []error
+}
+
+func (e *wrappedError) Error() string {
+	return e.msg
+}
+
+func (e *wrappedError) Is(target error) bool {
+	for _, err := range e.errs {
+		if errors.Is(err, target) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// kindError returns an error with the message that matches the kind,
+// one of the exported errors, with errors.Is.
+func kindError(kind error, msg string) error {
+	return &wrappedError{msg: msg, errs: []error{kind}}
+}
+
+// errWrap joins the error messages, one per line. The result matches
+// any of the errors with errors.Is.
 func errWrap(err error, es ...error) error {
 	s := make([]string, 0)
+	errs := make([]error, 0, len(es)+1)
 	if err != nil {
 		s = append(s, err.Error())
+		errs = append(errs, err)
 	}
 
 	for _, e := range es {
 		if e != nil {
 			s = append(s, e.Error())
+			errs = append(errs, e)
 		}
 	}
 
-	return errors.New(strings.Join(s, "\n"))
+	return &wrappedError{msg: strings.Join(s, "\n"), errs: errs}
 }
 
 // UnmarshalPoint return point by parse wkt point string
@@ -348,7 +391,7 @@ func unmarshal(s string) (geom orb.Geometry, err error) {
 			geom = pol
 		}
 	default:
-		return nil, errors.New("wkt: unsupported geometry")
+		return nil, ErrUnsupportedGeometry
 	}
 
 	return
@@ -1,6 +1,7 @@
 package wkt
 
 import (
+	"errors"
 	"testing"
 
 	"github.com/paulmach/orb"
@@ -274,3 +275,53 @@ func TestUnmarshalCollection(t *testing.T) {
 		}
 	}
 }
+
+func TestUnmarshal_errors(t *testing.T) {
+	cases := []struct {
+		name string
+		fn   func() error
+		err  error
+	}{
+		{
+			name: "invalid point",
+			fn: func() error {
+				_, err := UnmarshalPoint("POINT(1 a)")
+				return err
+			},
+			err: ErrNotWKT,
+		},
+		{
+			name: "invalid geometry in collection",
+			fn: func() error {
+				_, err := UnmarshalCollection("GEOMETRYCOLLECTION(POINT(1 a))")
+				return err
+			},
+			err: ErrNotWKT,
+		},
+		{
+			name: "incorrect geometry",
+			fn: func() error {
+				_, err := UnmarshalPoint("LINESTRING(1 2,3 4)")
+				return err
+			},
+			err: ErrIncorrectGeometry,
+		},
+		{
+			name: "unsupported geometry",
+			fn: func() error {
+				_, err := UnmarshalPolygon("TRIANGLE((0 0,1 0,0 1,0 0))")
+				return err
+			},
+			err: ErrUnsupportedGeometry,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.fn()
+			if !errors.Is(err, tc.err) {
+				t.Errorf("incorrect error: %v != %v", err, tc.err)
+			}
+		})
+	}
+}
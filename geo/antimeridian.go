@@ -0,0 +1,216 @@
+package geo
+
+import (
+	"math"
+
+	"github.com/paulmach/orb"
+	"github.com/paulmach/orb/clip"
+)
+
+// SplitAntimeridian splits line strings and polygons that cross the antimeridian,
+// ie. have consecutive points more than 180 degrees of longitude apart, into
+// multi-geometries with parts on either side. New points are added at +/-180
+// where the geometry crosses. Geometries that do not cross are returned unchanged.
+func SplitAntimeridian(g orb.Geometry) orb.Geometry {
+	if g == nil {
+		return nil
+	}
+
+	switch g := g.(type) {
+	case orb.LineString:
+		mls := splitLineString(g)
+		if len(mls) <= 1 {
+			return g
+		}
+		return mls
+	case orb.MultiLineString:
+		if !crossesAntimeridian(g) {
+			return g
+		}
+
+		var mls orb.MultiLineString
+		for _, ls := range g {
+			mls = append(mls, splitLineString(ls)...)
+		}
+		return mls
+	case orb.Ring:
+		mp := splitPolygon(orb.Polygon{g})
+		if mp == nil {
+			return g
+		}
+		return mp
+	case orb.Polygon:
+		mp := splitPolygon(g)
+		if mp == nil {
+			return g
+		}
+		return mp
+	case orb.MultiPolygon:
+		if !crossesAntimeridian(g) {
+			return g
+		}
+
+		var mp orb.MultiPolygon
+		for _, p := range g {
+			if split := splitPolygon(p); split != nil {
+				mp = append(mp, split...)
+			} else {
+				mp = append(mp, p)
+			}
+		}
+		return mp
+	case orb.Collection:
+		if !crossesAntimeridian(g) {
+			return g
+		}
+
+		c := make(orb.Collection, 0, len(g))
+		for _, geom := range g {
+			c = append(c, SplitAntimeridian(geom))
+		}
+		return c
+	}
+
+	// points and bounds do not have edges that can cross.
+	return g
+}
+
+func crossesAntimeridian(g orb.Geometry) bool {
+	switch g := g.(type) {
+	case orb.LineString:
+		return crossingIndex(g, 0) != -1
+	case orb.Ring:
+		return crossingIndex(orb.LineString(g), 0) != -1
+	case orb.MultiLineString:
+		for _, ls := range g {
+			if crossesAntimeridian(ls) {
+				return true
+			}
+		}
+	case orb.Polygon:
+		for _, r := range g {
+			if crossesAntimeridian(r) {
+				return true
+			}
+		}
+	case orb.MultiPolygon:
+		for _, p := range g {
+			if crossesAntimeridian(p) {
+				return true
+			}
+		}
+	case orb.Collection:
+		for _, geom := range g {
+			if crossesAntimeridian(geom) {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// crossingIndex returns the index of the first point, starting at
+// the given index, that is on the other side of the antimeridian
+// from the previous point. Returns -1 if there is no crossing.
+func crossingIndex(ls orb.LineString, start int) int {
+	for i := start + 1; i < len(ls); i++ {
+		if math.Abs(ls[i][0]-ls[i-1][0]) > 180 {
+			return i
+		}
+	}
+
+	return -1
+}
+
+func splitLineString(ls orb.LineString) orb.MultiLineString {
+	var result orb.MultiLineString
+
+	current := orb.LineString{}
+	for i, p := range ls {
+		if i > 0 && math.Abs(p[0]-ls[i-1][0]) > 180 {
+			prev := ls[i-1]
+
+			// unwrap the longitude and find the latitude at the crossing
+			lon := p[0] + 360
+			edge := 180.0
+			if prev[0] < 0 {
+				lon = p[0] - 360
+				edge = -180
+			}
+
+			lat := prev[1] + (p[1]-prev[1])*(edge-prev[0])/(lon-prev[0])
+			current = append(current, orb.Point{edge, lat})
+			result = append(result, current)
+
+			current = orb.LineString{{-edge, lat}}
+		}
+
+		current = append(current, p)
+	}
+
+	return append(result, current)
+}
+
+// splitPolygon returns the parts of the polygon on each side of
+// the antimeridian, or nil if it does not cross.
+func splitPolygon(p orb.Polygon) orb.MultiPolygon {
+	if len(p) == 0 || !crossesAntimeridian(p) {
+		return nil
+	}
+
+	// make the longitudes continuous, the holes are shifted
+	// to be near the outer ring.
+	unwrapped := make(orb.Polygon, len(p))
+	for i, r := range p {
+		unwrapped[i] = unwrapRing(r)
+		if i == 0 {
+			continue
+		}
+
+		if len(unwrapped[i]) > 0 {
+			shift := 360 * math.Round((unwrapped[0][0][0]-unwrapped[i][0][0])/360)
+			for j := range unwrapped[i] {
+				unwrapped[i][j][0] += shift
+			}
+		}
+	}
+
+	world := orb.Bound{Min: orb.Point{-180, -90}, Max: orb.Point{180, 90}}
+
+	var result orb.MultiPolygon
+	for _, shift := range []float64{0, -360, 360} {
+		shifted := unwrapped.Clone()
+		for _, r := range shifted {
+			for i := range r {
+				r[i][0] += shift
+			}
+		}
+
+		if part := clip.Polygon(world, shifted); len(part) > 0 {
+			result = append(result, part)
+		}
+	}
+
+	return result
+}
+
+// unwrapRing returns a copy of the ring with longitudes adjusted by
+// multiples of 360 so that consecutive points are less than 180 apart.
+func unwrapRing(r orb.Ring) orb.Ring {
+	result := make(orb.Ring, len(r))
+	offset := 0.0
+	for i, p := range r {
+		if i > 0 {
+			if d := p[0] - r[i-1][0]; d > 180 {
+				offset -= 360
+			} else if d < -180 {
+				offset += 360
+			}
+		}
+
+		result[i] = orb.Point{p[0] + offset, p[1]}
+	}
+
+	return result
+}
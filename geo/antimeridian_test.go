@@ -0,0 +1,96 @@
+package geo
+
+import (
+	"testing"
+
+	"github.com/paulmach/orb"
+	"github.com/paulmach/orb/planar"
+)
+
+func TestSplitAntimeridian_lineString(t *testing.T) {
+	ls := orb.LineString{{170, 0}, {-170, 10}, {-160, 10}, {170, 20}}
+
+	result := SplitAntimeridian(ls)
+	expected := orb.MultiLineString{
+		{{170, 0}, {180, 5}},
+		{{-180, 5}, {-170, 10}, {-160, 10}, {-180, 10 + 10*(20.0/30.0)}},
+		{{180, 10 + 10*(20.0/30.0)}, {170, 20}},
+	}
+
+	if !orb.Equal(result, expected) {
+		t.Errorf("incorrect split: %v", result)
+	}
+
+	// not crossing
+	ls = orb.LineString{{-10, 0}, {10, 10}, {170, 10}}
+	if v := SplitAntimeridian(ls); !orb.Equal(v, ls) {
+		t.Errorf("should not split: %v", v)
+	}
+}
+
+func TestSplitAntimeridian_polygon(t *testing.T) {
+	p := orb.Polygon{
+		{{170, -10}, {-170, -10}, {-170, 10}, {170, 10}, {170, -10}},
+	}
+
+	result, ok := SplitAntimeridian(p).(orb.MultiPolygon)
+	if !ok || len(result) != 2 {
+		t.Fatalf("should split into two polygons: %v", result)
+	}
+
+	for _, part := range result {
+		b := part.Bound()
+		if b.Max[0]-b.Min[0] != 10 || b.Max[1]-b.Min[1] != 20 {
+			t.Errorf("incorrect part: %v", part)
+		}
+
+		if a := planar.Area(part); a != 200 {
+			t.Errorf("incorrect area: %v", a)
+		}
+	}
+
+	if v := result.Bound(); v.Min[0] != -180 || v.Max[0] != 180 {
+		t.Errorf("parts should be on either side: %v", v)
+	}
+
+	// with a hole across the antimeridian
+	p = append(p, orb.Ring{{175, -5}, {175, 5}, {-175, 5}, {-175, -5}, {175, -5}})
+	result = SplitAntimeridian(p).(orb.MultiPolygon)
+	area := 0.0
+	for _, part := range result {
+		if len(part) != 2 {
+			t.Errorf("part should have a hole: %v", part)
+		}
+		area += planar.Area(part)
+	}
+
+	if area != 400-100 {
+		t.Errorf("incorrect area: %v", area)
+	}
+
+	// not crossing
+	p = orb.Polygon{{{0, 0}, {1, 0}, {1, 1}, {0, 0}}}
+	if v := SplitAntimeridian(p); !orb.Equal(v, p) {
+		t.Errorf("should not split: %v", v)
+	}
+}
+
+func TestSplitAntimeridian_other(t *testing.T) {
+	c := orb.Collection{
+		orb.Point{1, 2},
+		orb.LineString{{170, 0}, {-170, 0}},
+	}
+
+	result := SplitAntimeridian(c).(orb.Collection)
+	if _, ok := result[1].(orb.MultiLineString); !ok {
+		t.Errorf("should split the line string: %v", result)
+	}
+
+	if !orb.Equal(result[0], c[0]) {
+		t.Errorf("point should be unchanged: %v", result[0])
+	}
+
+	if v := SplitAntimeridian(nil); v != nil {
+		t.Errorf("nil should return nil: %v", v)
+	}
+}
@@ -60,6 +60,86 @@ func BoundPad(b orb.Bound, meters float64) orb.Bound {
 	return b
 }
 
+// Bound returns the bound of the geometry taking into account that the edges
+// are great circle arcs. The highest (or lowest) latitude of an arc can be
+// beyond both of its endpoints, eg. flight paths that bow towards the pole, and
+// the planar bound would cut off that part of the path. For points, and edges
+// along meridians, the result matches the planar bound. Longitudes are not
+// wrapped, so edges crossing the antimeridian should be split first,
+// see SplitAntimeridian.
+func Bound(g orb.Geometry) orb.Bound {
+	b := g.Bound()
+	if b.IsEmpty() {
+		return b
+	}
+
+	switch g := g.(type) {
+	case orb.LineString:
+		return extendArcs(b, g)
+	case orb.MultiLineString:
+		for _, ls := range g {
+			b = extendArcs(b, ls)
+		}
+	case orb.Ring:
+		return extendArcs(b, orb.LineString(g))
+	case orb.Polygon:
+		for _, r := range g {
+			b = extendArcs(b, orb.LineString(r))
+		}
+	case orb.MultiPolygon:
+		for _, p := range g {
+			for _, r := range p {
+				b = extendArcs(b, orb.LineString(r))
+			}
+		}
+	case orb.Collection:
+		b = Bound(g[0])
+		for _, c := range g[1:] {
+			b = b.Union(Bound(c))
+		}
+	}
+
+	return b
+}
+
+// extendArcs extends the latitude of the bound to include the northern
+// and southern most points of the great circle arcs of the line string.
+func extendArcs(b orb.Bound, ls orb.LineString) orb.Bound {
+	for i := 0; i < len(ls)-1; i++ {
+		ax, ay, az := toUnitVector(ls[i])
+		bx, by, bz := toUnitVector(ls[i+1])
+
+		// normal of the great circle plane
+		nx := ay*bz - az*by
+		ny := az*bx - ax*bz
+		nz := ax*by - ay*bx
+
+		h := math.Sqrt(nx*nx + ny*ny)
+		if h == 0 {
+			// same, antipodal or both points on the equator
+			continue
+		}
+
+		// the northern most point of the great circle, the southern
+		// most point is the opposite. The length doesn't matter.
+		vx, vy, vz := -nz*nx, -nz*ny, h*h
+		lat := rad2deg(math.Atan2(h, math.Abs(nz)))
+
+		// the vertex is on the arc if it's between the endpoints when
+		// going around the normal
+		sa := nx*(ay*vz-az*vy) + ny*(az*vx-ax*vz) + nz*(ax*vy-ay*vx)
+		sb := nx*(vy*bz-vz*by) + ny*(vz*bx-vx*bz) + nz*(vx*by-vy*bx)
+
+		if sa > 0 && sb > 0 {
+			b.Max[1] = math.Max(b.Max[1], lat)
+		} else if sa < 0 && sb < 0 {
+			b.Min[1] = math.Min(b.Min[1], -lat)
+		}
+	}
+
+	return b
+}
+
 // BoundHeight returns the approximate height in meters.
 func BoundHeight(b orb.Bound) float64 {
 	return 111131.75 * (b.Max[1] - b.Min[1])
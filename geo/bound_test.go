@@ -85,3 +85,63 @@ func TestBoundPad(t *testing.T) {
 		t.Errorf("should be extend bound around fill earth: %v", b2)
 	}
 }
+
+func TestBound(t *testing.T) {
+	cases := []struct {
+		name string
+		geom orb.Geometry
+	}{
+		{name: "northern arc", geom: orb.LineString{{-70, 45}, {70, 45}}},
+		{name: "southern arc", geom: orb.LineString{{-70, -45}, {70, -45}}},
+		{name: "flight path", geom: orb.LineString{{-74.0, 40.7}, {-0.1, 51.5}, {139.7, 35.7}}},
+		{name: "ring", geom: orb.Ring{{-60, 50}, {60, 50}, {60, 60}, {-60, 60}, {-60, 50}}},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			b := Bound(tc.geom)
+
+			// sample the arcs to find the expected bound
+			expected := tc.geom.Bound()
+			ls := orb.LineString{}
+			switch g := tc.geom.(type) {
+			case orb.LineString:
+				ls = g
+			case orb.Ring:
+				ls = orb.LineString(g)
+			}
+
+			for i := 0; i < len(ls)-1; i++ {
+				for f := 0.0; f <= 1; f += 0.0001 {
+					expected = expected.Extend(Interpolate(ls[i], ls[i+1], f))
+				}
+			}
+
+			if math.Abs(b.Min[1]-expected.Min[1]) > 1e-4 || math.Abs(b.Max[1]-expected.Max[1]) > 1e-4 {
+				t.Errorf("incorrect bound: %v != %v", b, expected)
+			}
+
+			if b.Min[0] != expected.Min[0] || b.Max[0] != expected.Max[0] {
+				t.Errorf("longitudes should not change: %v != %v", b, expected)
+			}
+		})
+	}
+
+	// no curvature, should match the planar bound
+	same := []orb.Geometry{
+		orb.MultiPoint{{1, 2}, {-50, 60}},
+		orb.LineString{{10, 10}, {10, 50}},
+		orb.LineString{{-50, 0}, {50, 0}},
+		orb.Collection{orb.Point{1, 2}, orb.LineString{{10, 10}, {10, 50}}},
+	}
+
+	for _, g := range same {
+		if b := Bound(g); !b.Equal(g.Bound()) {
+			t.Errorf("%T: should match planar bound: %v != %v", g, b, g.Bound())
+		}
+	}
+
+	if b := Bound(orb.MultiLineString{{{-70, 45}, {70, 45}}}); b.Max[1] <= 45 {
+		t.Errorf("should extend multi line strings: %v", b)
+	}
+}
@@ -0,0 +1,43 @@
+package geo
+
+import (
+	"math"
+
+	"github.com/paulmach/orb"
+)
+
+// Centroid returns the center of the lon/lat points on the sphere.
+// The points are averaged in 3d cartesian space and projected back
+// to lon/lat, so it works correctly across the antimeridian and near the poles.
+// An empty set, or points that cancel out such as two antipodal points,
+// return the zero point.
+func Centroid(mp orb.MultiPoint) orb.Point {
+	if len(mp) == 0 {
+		return orb.Point{}
+	}
+
+	x, y, z := 0.0, 0.0, 0.0
+	for _, p := range mp {
+		lon := deg2rad(p[0])
+		lat := deg2rad(p[1])
+
+		x += math.Cos(lat) * math.Cos(lon)
+		y += math.Cos(lat) * math.Sin(lon)
+		z += math.Sin(lat)
+	}
+
+	num := float64(len(mp))
+	x /= num
+	y /= num
+	z /= num
+
+	hyp := math.Sqrt(x*x + y*y)
+	if hyp < 1e-12 && math.Abs(z) < 1e-12 {
+		return orb.Point{}
+	}
+
+	return orb.Point{
+		rad2deg(math.Atan2(y, x)),
+		rad2deg(math.Atan2(z, hyp)),
+	}
+}
@@ -0,0 +1,62 @@
+package geo
+
+import (
+	"math"
+	"testing"
+
+	"github.com/paulmach/orb"
+)
+
+func TestCentroid(t *testing.T) {
+	cases := []struct {
+		name     string
+		mp       orb.MultiPoint
+		expected orb.Point
+	}{
+		{
+			name:     "single point",
+			mp:       orb.MultiPoint{{-122.4, 37.8}},
+			expected: orb.Point{-122.4, 37.8},
+		},
+		{
+			name:     "equator",
+			mp:       orb.MultiPoint{{-10, 0}, {10, 0}},
+			expected: orb.Point{0, 0},
+		},
+		{
+			name:     "antimeridian",
+			mp:       orb.MultiPoint{{179, 0}, {-179, 0}},
+			expected: orb.Point{180, 0},
+		},
+		{
+			name:     "pole",
+			mp:       orb.MultiPoint{{0, 80}, {90, 80}, {180, 80}, {-90, 80}},
+			expected: orb.Point{0, 90},
+		},
+		{
+			name:     "empty",
+			mp:       orb.MultiPoint{},
+			expected: orb.Point{},
+		},
+		{
+			name:     "antipodal",
+			mp:       orb.MultiPoint{{0, 0}, {180, 0}},
+			expected: orb.Point{},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			c := Centroid(tc.mp)
+
+			// longitude is undefined at the poles
+			if math.Abs(tc.expected[1]) != 90 && math.Abs(math.Abs(c[0])-math.Abs(tc.expected[0])) > epsilon {
+				t.Errorf("incorrect lon: %v != %v", c, tc.expected)
+			}
+
+			if math.Abs(c[1]-tc.expected[1]) > epsilon {
+				t.Errorf("incorrect lat: %v != %v", c, tc.expected)
+			}
+		})
+	}
+}
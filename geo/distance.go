@@ -24,6 +24,14 @@ func Distance(p1, p2 orb.Point) float64 {
 // DistanceHaversine computes the distance on the earth using the
 // more accurate haversine formula.
 func DistanceHaversine(p1, p2 orb.Point) float64 {
+	return DistanceIn(p1, p2, orb.EarthRadius)
+}
+
+// DistanceIn computes the great circle distance, using the haversine formula,
+// on a sphere of the given radius. The result is in the same unit as the radius,
+// e.g. orb.EarthRadius/1000 for kilometers matching the other functions,
+// or 3440.065 for nautical miles.
+func DistanceIn(p1, p2 orb.Point, radius float64) float64 {
 	dLat := deg2rad(p1[1] - p2[1])
 	dLon := deg2rad(p1[0] - p2[0])
 
@@ -31,7 +39,7 @@ func DistanceHaversine(p1, p2 orb.Point) float64 {
 	dLon2Sin := math.Sin(dLon / 2)
 	a := dLat2Sin*dLat2Sin + math.Cos(deg2rad(p2[1]))*math.Cos(deg2rad(p1[1]))*dLon2Sin*dLon2Sin
 
-	return 2.0 * orb.EarthRadius * math.Atan2(math.Sqrt(a), math.Sqrt(1-a))
+	return 2.0 * radius * math.Atan2(math.Sqrt(a), math.Sqrt(1-a))
 }
 
 // Bearing computes the direction one must start traveling on earth
@@ -48,6 +56,21 @@ func Bearing(from, to orb.Point) float64 {
 	return rad2deg(math.Atan2(y, x))
 }
 
+// BearingSigned returns the bearing from, to the given points in degrees,
+// normalized to the range (-180, 180]. Positive values are clockwise from north.
+// Unlike Bearing, due south is always 180 and never -180, so signed bearings
+// can be compared directly, e.g. to find the direction of a turn.
+func BearingSigned(from, to orb.Point) float64 {
+	b := math.Mod(Bearing(from, to), 360)
+	if b <= -180 {
+		b += 360
+	} else if b > 180 {
+		b -= 360
+	}
+
+	return b
+}
+
 // Midpoint returns the half-way point along a great circle path between the two points.
 func Midpoint(p, p2 orb.Point) orb.Point {
 	dLon := deg2rad(p2[0] - p[0])
@@ -70,6 +93,59 @@ func Midpoint(p, p2 orb.Point) orb.Point {
 	return r
 }
 
+// Interpolate returns the point the given fraction of the way along
+// the great circle path between the two points. A fraction of 0 returns a
+// and 1 returns b. The path between antipodal points is undefined, in that
+// case a is returned for fractions below 0.5 and b otherwise.
+func Interpolate(a, b orb.Point, fraction float64) orb.Point {
+	if fraction == 0 {
+		return a
+	}
+
+	if fraction == 1 {
+		return b
+	}
+
+	ax, ay, az := toUnitVector(a)
+	bx, by, bz := toUnitVector(b)
+
+	// angle between the vectors, atan2 is stable for small and large angles
+	cx := ay*bz - az*by
+	cy := az*bx - ax*bz
+	cz := ax*by - ay*bx
+	omega := math.Atan2(math.Sqrt(cx*cx+cy*cy+cz*cz), ax*bx+ay*by+az*bz)
+
+	sinOmega := math.Sin(omega)
+	if sinOmega < 1e-12 {
+		if omega > math.Pi/2 && fraction >= 0.5 {
+			return b
+		}
+
+		return a
+	}
+
+	wa := math.Sin((1-fraction)*omega) / sinOmega
+	wb := math.Sin(fraction*omega) / sinOmega
+
+	x := wa*ax + wb*bx
+	y := wa*ay + wb*by
+	z := wa*az + wb*bz
+
+	return orb.Point{
+		rad2deg(math.Atan2(y, x)),
+		rad2deg(math.Atan2(z, math.Sqrt(x*x+y*y))),
+	}
+}
+
+func toUnitVector(p orb.Point) (float64, float64, float64) {
+	lon := deg2rad(p[0])
+	lat := deg2rad(p[1])
+
+	return math.Cos(lat) * math.Cos(lon),
+		math.Cos(lat) * math.Sin(lon),
+		math.Sin(lat)
+}
+
 // PointAtBearingAndDistance returns the point at the given bearing and distance in meters from the point
 func PointAtBearingAndDistance(p orb.Point, bearing, distance float64) orb.Point {
 	aLat := deg2rad(p[1])
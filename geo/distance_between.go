@@ -0,0 +1,125 @@
+package geo
+
+import (
+	"math"
+
+	"github.com/paulmach/orb"
+	"github.com/paulmach/orb/planar"
+)
+
+// DistanceBetween returns the minimum great circle distance, in meters,
+// between any parts of the two lon/lat geometries. It is 0 if they intersect,
+// including when one is inside the area of a polygon of the other. The
+// intersection test is done on the lon/lat coordinates while distances from
+// lines use the cross-track distance. If either geometry is nil or empty the
+// result is +Inf.
+func DistanceBetween(a, b orb.Geometry) float64 {
+	d := planar.DistanceBetween(a, b)
+	if d == 0 || math.IsInf(d, 1) {
+		return d
+	}
+
+	pointsA, segmentsA := distanceParts(a, nil, nil)
+	pointsB, segmentsB := distanceParts(b, nil, nil)
+
+	best := math.Inf(1)
+	for _, p := range pointsA {
+		for _, q := range pointsB {
+			best = math.Min(best, DistanceHaversine(p, q))
+		}
+
+		for _, s := range segmentsB {
+			best = math.Min(best, distanceFromSegment(s[0], s[1], p))
+		}
+	}
+
+	for _, s := range segmentsA {
+		for _, q := range pointsB {
+			best = math.Min(best, distanceFromSegment(s[0], s[1], q))
+		}
+
+		// segments that do not intersect are nearest at one of the endpoints
+		for _, t := range segmentsB {
+			best = math.Min(best, distanceFromSegment(s[0], s[1], t[0]))
+			best = math.Min(best, distanceFromSegment(s[0], s[1], t[1]))
+			best = math.Min(best, distanceFromSegment(t[0], t[1], s[0]))
+			best = math.Min(best, distanceFromSegment(t[0], t[1], s[1]))
+		}
+	}
+
+	return best
+}
+
+// distanceParts appends the points that are not part of a segment,
+// and the segments, of the geometry.
+func distanceParts(g orb.Geometry, points []orb.Point, segments [][2]orb.Point) ([]orb.Point, [][2]orb.Point) {
+	path := func(ps []orb.Point) {
+		if len(ps) == 1 {
+			points = append(points, ps[0])
+		}
+
+		for i := 0; i < len(ps)-1; i++ {
+			segments = append(segments, [2]orb.Point{ps[i], ps[i+1]})
+		}
+	}
+
+	switch g := g.(type) {
+	case orb.Point:
+		points = append(points, g)
+	case orb.MultiPoint:
+		points = append(points, g...)
+	case orb.LineString:
+		path(g)
+	case orb.MultiLineString:
+		for _, ls := range g {
+			path(ls)
+		}
+	case orb.Ring:
+		path(g)
+	case orb.Polygon:
+		for _, r := range g {
+			path(r)
+		}
+	case orb.MultiPolygon:
+		for _, p := range g {
+			for _, r := range p {
+				path(r)
+			}
+		}
+	case orb.Collection:
+		for _, c := range g {
+			points, segments = distanceParts(c, points, segments)
+		}
+	case orb.Bound:
+		path(g.ToRing())
+	}
+
+	return points, segments
+}
+
+// distanceFromSegment returns the distance, in meters, from the point to the
+// great circle segment [a, b] using the cross-track and along-track distances.
+func distanceFromSegment(a, b, p orb.Point) float64 {
+	if a == b {
+		return DistanceHaversine(a, p)
+	}
+
+	d13 := DistanceHaversine(a, p) / orb.EarthRadius
+	d12 := DistanceHaversine(a, b) / orb.EarthRadius
+	angle := deg2rad(Bearing(a, p) - Bearing(a, b))
+
+	// the point is behind the start of the segment
+	if math.Cos(angle) <= 0 {
+		return d13 * orb.EarthRadius
+	}
+
+	dxt := math.Asin(math.Sin(d13) * math.Sin(angle))
+
+	c := math.Cos(d13) / math.Cos(dxt)
+	dat := math.Acos(math.Max(-1, math.Min(1, c)))
+	if dat >= d12 {
+		return DistanceHaversine(b, p)
+	}
+
+	return math.Abs(dxt) * orb.EarthRadius
+}
@@ -0,0 +1,87 @@
+package geo
+
+import (
+	"math"
+	"testing"
+
+	"github.com/paulmach/orb"
+)
+
+func TestDistanceBetween(t *testing.T) {
+	square := orb.Polygon{{{0, 0}, {1, 0}, {1, 1}, {0, 1}, {0, 0}}}
+	equator := orb.LineString{{0, 0}, {1, 0}}
+
+	cases := []struct {
+		name     string
+		a, b     orb.Geometry
+		distance float64
+	}{
+		{
+			name:     "points",
+			a:        orb.Point{0, 0},
+			b:        orb.Point{1, 1},
+			distance: DistanceHaversine(orb.Point{0, 0}, orb.Point{1, 1}),
+		},
+		{
+			name:     "point next to line",
+			a:        orb.Point{0.5, 1},
+			b:        equator,
+			distance: DistanceHaversine(orb.Point{0.5, 0}, orb.Point{0.5, 1}),
+		},
+		{
+			name:     "point beyond the end of the line",
+			a:        orb.Point{2, 1},
+			b:        equator,
+			distance: DistanceHaversine(orb.Point{1, 0}, orb.Point{2, 1}),
+		},
+		{
+			name:     "point behind the start of the line",
+			a:        orb.Point{-1, -1},
+			b:        equator,
+			distance: DistanceHaversine(orb.Point{0, 0}, orb.Point{-1, -1}),
+		},
+		{
+			name:     "point inside polygon",
+			a:        orb.Point{0.5, 0.5},
+			b:        square,
+			distance: 0,
+		},
+		{
+			name:     "crossing lines",
+			a:        orb.LineString{{0.5, -1}, {0.5, 1}},
+			b:        equator,
+			distance: 0,
+		},
+		{
+			name:     "separate polygons",
+			a:        square,
+			b:        orb.Polygon{{{2, 0}, {3, 0}, {3, 1}, {2, 1}, {2, 0}}},
+			distance: DistanceHaversine(orb.Point{1, 1}, orb.Point{2, 1}),
+		},
+		{
+			name:     "empty",
+			a:        orb.MultiPoint{},
+			b:        square,
+			distance: math.Inf(1),
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			if d := DistanceBetween(tc.a, tc.b); math.Abs(d-tc.distance) > epsilon && d != tc.distance {
+				t.Errorf("incorrect distance: %v != %v", d, tc.distance)
+			}
+
+			if d := DistanceBetween(tc.b, tc.a); math.Abs(d-tc.distance) > epsilon && d != tc.distance {
+				t.Errorf("incorrect reverse distance: %v != %v", d, tc.distance)
+			}
+		})
+	}
+
+	// the cross-track distance from a meridian is a bit less than along the parallel
+	d := DistanceBetween(orb.Point{1, 45}, orb.LineString{{0, 40}, {0, 50}})
+	expected := math.Asin(math.Sin(deg2rad(1))*math.Cos(deg2rad(45))) * orb.EarthRadius
+	if math.Abs(d-expected) > 1e-3 {
+		t.Errorf("incorrect cross-track distance: %v != %v", d, expected)
+	}
+}
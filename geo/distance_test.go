@@ -50,6 +50,25 @@ func TestDistanceHaversine(t *testing.T) {
 	}
 }
 
+func TestDistanceIn(t *testing.T) {
+	p1 := orb.Point{-1.8444, 53.1506}
+	p2 := orb.Point{0.1406, 52.2047}
+
+	meters := DistanceHaversine(p1, p2)
+	if d := DistanceIn(p1, p2, orb.EarthRadius); d != meters {
+		t.Errorf("should match haversine distance: %v != %v", d, meters)
+	}
+
+	if d := DistanceIn(p1, p2, orb.EarthRadius/1000); math.Abs(d-meters/1000) > epsilon {
+		t.Errorf("incorrect kilometers: %v", d)
+	}
+
+	// one degree along the equator is 60 nautical miles
+	if d := DistanceIn(orb.Point{0, 0}, orb.Point{1, 0}, 3437.74677); math.Abs(d-60) > 1e-6 {
+		t.Errorf("incorrect nautical miles: %v", d)
+	}
+}
+
 func TestBearing(t *testing.T) {
 	p1 := orb.Point{0, 0}
 	p2 := orb.Point{0, 1}
@@ -81,6 +100,28 @@ func TestBearing(t *testing.T) {
 	}
 }
 
+func TestBearingSigned(t *testing.T) {
+	cases := []struct {
+		name     string
+		from, to orb.Point
+		expected float64
+	}{
+		{name: "north", from: orb.Point{0, 0}, to: orb.Point{0, 1}, expected: 0},
+		{name: "east", from: orb.Point{0, 0}, to: orb.Point{1, 0}, expected: 90},
+		{name: "west", from: orb.Point{1, 0}, to: orb.Point{0, 0}, expected: -90},
+		{name: "south", from: orb.Point{0, 1}, to: orb.Point{0, 0}, expected: 180},
+		{name: "south with negative zero", from: orb.Point{0, 0}, to: orb.Point{math.Copysign(0, -1), -1}, expected: 180},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			if d := BearingSigned(tc.from, tc.to); d != tc.expected {
+				t.Errorf("incorrect bearing: %v != %v", d, tc.expected)
+			}
+		})
+	}
+}
+
 func TestMidpoint(t *testing.T) {
 	answer := orb.Point{-0.841153, 52.68179432}
 	m := Midpoint(orb.Point{-1.8444, 53.1506}, orb.Point{0.1406, 52.2047})
@@ -90,6 +131,44 @@ func TestMidpoint(t *testing.T) {
 	}
 }
 
+func TestInterpolate(t *testing.T) {
+	a := orb.Point{-1.8444, 53.1506}
+	b := orb.Point{0.1406, 52.2047}
+
+	if p := Interpolate(a, b, 0); !p.Equal(a) {
+		t.Errorf("fraction 0 should return a: %v", p)
+	}
+
+	if p := Interpolate(a, b, 1); !p.Equal(b) {
+		t.Errorf("fraction 1 should return b: %v", p)
+	}
+
+	answer := Midpoint(a, b)
+	if p := Interpolate(a, b, 0.5); Distance(p, answer) > 1 {
+		t.Errorf("expected %v, got %v", answer, p)
+	}
+
+	// points should be evenly spaced along the path
+	total := DistanceHaversine(a, b)
+	for _, f := range []float64{0.1, 0.25, 0.75, 0.9} {
+		p := Interpolate(a, b, f)
+		if d := DistanceHaversine(a, p); math.Abs(d-f*total) > 1 {
+			t.Errorf("%v: incorrect distance: %v != %v", f, d, f*total)
+		}
+	}
+
+	// across the antimeridian
+	p := Interpolate(orb.Point{179, 0}, orb.Point{-179, 0}, 0.5)
+	if math.Abs(math.Abs(p[0])-180) > 1e-9 || math.Abs(p[1]) > 1e-9 {
+		t.Errorf("should cross the antimeridian: %v", p)
+	}
+
+	// same point
+	if p := Interpolate(a, a, 0.3); !p.Equal(a) {
+		t.Errorf("same points should return the point: %v", p)
+	}
+}
+
 func TestPointAtBearingAndDistance(t *testing.T) {
 	cases := []struct {
 		name     string
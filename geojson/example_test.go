@@ -48,7 +48,7 @@ func ExampleFeatureCollection_foreignMembers() {
 	fmt.Println(string(data))
 
 	// Output:
-	// [102 0.5]
+	// POINT(102 0.5)
 	// Title as Foreign Member
 	// {"features":[{"type":"Feature","geometry":{"type":"Point","coordinates":[102,0.5]},"properties":{"prop0":"value0"}}],"title":"Title as Foreign Member","type":"FeatureCollection"}
 }
@@ -96,8 +96,8 @@ func ExampleFeatureCollection_foreignMembersCustom() {
 	fmt.Println(fc.Features[0].Geometry)
 	fmt.Println(fc.Title)
 	// Output:
-	// [102 0.5]
-	// [102 0.5]
+	// POINT(102 0.5)
+	// POINT(102 0.5)
 	// Title as Foreign Member
 }
 
@@ -119,7 +119,7 @@ func ExampleUnmarshalFeatureCollection() {
 	fmt.Println(point)
 
 	// Output:
-	// [102 0.5]
+	// POINT(102 0.5)
 }
 
 func Example_unmarshal() {
@@ -144,7 +144,7 @@ func Example_unmarshal() {
 	fmt.Println(point)
 
 	// Output:
-	// [102 0.5]
+	// POINT(102 0.5)
 }
 
 func ExampleFeatureCollection_MarshalJSON() {
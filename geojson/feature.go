@@ -1,8 +1,11 @@
 package geojson
 
 import (
+	"bytes"
 	"encoding/json"
 	"fmt"
+	"math"
+	"strconv"
 
 	"github.com/paulmach/orb"
 )
@@ -34,11 +37,50 @@ func (f *Feature) Point() orb.Point {
 
 var _ orb.Pointer = &Feature{}
 
+// StringID returns the id of the feature if it is a string.
+func (f *Feature) StringID() (string, bool) {
+	id, ok := f.ID.(string)
+	return id, ok
+}
+
+// IntID returns the id of the feature if it is an integer number.
+// Numbers with a fractional part and string ids return false.
+func (f *Feature) IntID() (int64, bool) {
+	switch id := f.ID.(type) {
+	case int:
+		return int64(id), true
+	case int32:
+		return int64(id), true
+	case int64:
+		return id, true
+	case uint32:
+		return int64(id), true
+	case uint64:
+		if id > math.MaxInt64 {
+			return 0, false
+		}
+		return int64(id), true
+	case float64:
+		if id != math.Trunc(id) || math.Abs(id) >= maxExactFloat {
+			return 0, false
+		}
+		return int64(id), true
+	case json.Number:
+		i, err := id.Int64()
+		if err != nil {
+			return 0, false
+		}
+		return i, true
+	}
+
+	return 0, false
+}
+
 // MarshalJSON converts the feature object into the proper JSON.
 // It will handle the encoding of all the child geometries.
 // Alternately one can call json.Marshal(f) directly for the same result.
 func (f Feature) MarshalJSON() ([]byte, error) {
-	jf := &jsonFeature{
+	jf := &jsonFeatureMarshall{
 		ID:         f.ID,
 		Type:       "Feature",
 		Properties: f.Properties,
@@ -78,6 +120,11 @@ func (f *Feature) UnmarshalJSON(data []byte) error {
 		return fmt.Errorf("geojson: not a feature: type=%s", jf.Type)
 	}
 
+	id, err := unmarshalFeatureID(jf.ID)
+	if err != nil {
+		return err
+	}
+
 	var g orb.Geometry
 	if jf.Geometry != nil {
 		if jf.Geometry.Coordinates == nil && jf.Geometry.Geometries == nil {
@@ -87,7 +134,7 @@ func (f *Feature) UnmarshalJSON(data []byte) error {
 	}
 
 	*f = Feature{
-		ID:         jf.ID,
+		ID:         id,
 		Type:       jf.Type,
 		Properties: jf.Properties,
 		BBox:       jf.BBox,
@@ -97,7 +144,57 @@ func (f *Feature) UnmarshalJSON(data []byte) error {
 	return nil
 }
 
+// maxExactFloat is the bound below which all integers can be
+// represented exactly by a float64.
+const maxExactFloat = 1 << 53
+
+// unmarshalFeatureID decodes the raw id so the number vs. string form is
+// preserved on a round trip. Strings are returned as strings. Numbers are
+// returned as float64, unless they are integers too large to be represented
+// exactly, in which case they are returned as a json.Number.
+func unmarshalFeatureID(data []byte) (interface{}, error) {
+	data = bytes.TrimSpace(data)
+	if len(data) == 0 || bytes.Equal(data, []byte(`null`)) {
+		return nil, nil
+	}
+
+	var id interface{}
+	d := json.NewDecoder(bytes.NewReader(data))
+	d.UseNumber()
+	if err := d.Decode(&id); err != nil {
+		return nil, err
+	}
+
+	n, ok := id.(json.Number)
+	if !ok {
+		return id, nil
+	}
+
+	if _, err := strconv.ParseInt(string(n), 10, 64); err == nil {
+		f, _ := n.Float64()
+		if math.Abs(f) >= maxExactFloat {
+			return n, nil
+		}
+
+		return f, nil
+	}
+
+	if _, err := strconv.ParseUint(string(n), 10, 64); err == nil {
+		return n, nil
+	}
+
+	return n.Float64()
+}
+
 type jsonFeature struct {
+	ID         nocopyRawMessage `json:"id,omitempty"`
+	Type       string           `json:"type"`
+	BBox       BBox             `json:"bbox,omitempty"`
+	Geometry   *Geometry        `json:"geometry"`
+	Properties Properties       `json:"properties"`
+}
+
+type jsonFeatureMarshall struct {
 	ID         interface{} `json:"id,omitempty"`
 	Type       string      `json:"type"`
 	BBox       BBox        `json:"bbox,omitempty"`
@@ -9,6 +9,8 @@ package geojson
 import (
 	"encoding/json"
 	"fmt"
+
+	"github.com/paulmach/orb"
 )
 
 const featureCollection = "FeatureCollection"
@@ -66,6 +68,18 @@ func (fc FeatureCollection) MarshalJSON() ([]byte, error) {
 	return json.Marshal(tmp)
 }
 
+// MarshalCollection encodes the geometries as a feature collection where every
+// feature has one of the geometries and no properties. Nil geometries become
+// features with a null geometry.
+func MarshalCollection(gs []orb.Geometry) ([]byte, error) {
+	fc := NewFeatureCollection()
+	for _, g := range gs {
+		fc.Append(NewFeature(g))
+	}
+
+	return fc.MarshalJSON()
+}
+
 // UnmarshalJSON decodes the data into a GeoJSON feature collection.
 // Extra/foreign members will be put into the `ExtraMembers` attribute.
 func (fc *FeatureCollection) UnmarshalJSON(data []byte) error {
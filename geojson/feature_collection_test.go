@@ -184,6 +184,44 @@ func TestFeatureCollectionMarshalJSON(t *testing.T) {
 	}
 }
 
+func TestMarshalCollection(t *testing.T) {
+	data, err := MarshalCollection([]orb.Geometry{
+		orb.Point{1, 2},
+		nil,
+		orb.Polygon{{{0, 0}, {1, 0}, {1, 1}, {0, 0}}},
+	})
+	if err != nil {
+		t.Fatalf("marshal error: %v", err)
+	}
+
+	expected := `{"features":[` +
+		`{"type":"Feature","geometry":{"type":"Point","coordinates":[1,2]},"properties":null},` +
+		`{"type":"Feature","geometry":null,"properties":null},` +
+		`{"type":"Feature","geometry":{"type":"Polygon","coordinates":[[[0,0],[1,0],[1,1],[0,0]]]},"properties":null}` +
+		`],"type":"FeatureCollection"}`
+	if string(data) != expected {
+		t.Errorf("incorrect json:\n%s\n%s", data, expected)
+	}
+
+	fc, err := UnmarshalFeatureCollection(data)
+	if err != nil {
+		t.Fatalf("unmarshal error: %v", err)
+	}
+
+	if len(fc.Features) != 3 || fc.Features[1].Geometry != nil {
+		t.Errorf("incorrect features: %v", fc.Features)
+	}
+
+	data, err = MarshalCollection(nil)
+	if err != nil {
+		t.Fatalf("marshal error: %v", err)
+	}
+
+	if string(data) != `{"features":[],"type":"FeatureCollection"}` {
+		t.Errorf("incorrect empty json: %s", data)
+	}
+}
+
 func TestFeatureCollectionMarshal(t *testing.T) {
 	fc := NewFeatureCollection()
 	fc.Features = nil
@@ -266,6 +266,101 @@ func TestUnmarshalFeatureID(t *testing.T) {
 	}
 }
 
+func TestFeatureID_roundTrip(t *testing.T) {
+	cases := []struct {
+		name string
+		id   string
+	}{
+		{name: "string", id: `"abcd"`},
+		{name: "numeric string", id: `"123"`},
+		{name: "integer", id: `123`},
+		{name: "negative integer", id: `-123`},
+		{name: "float", id: `1.5`},
+		{name: "large integer", id: `9007199254740993`},
+		{name: "large unsigned integer", id: `18446744073709551615`},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			data := []byte(`{"id":` + tc.id + `,"type":"Feature","geometry":null,"properties":null}`)
+			f, err := UnmarshalFeature(data)
+			if err != nil {
+				t.Fatalf("unmarshal error: %v", err)
+			}
+
+			result, err := f.MarshalJSON()
+			if err != nil {
+				t.Fatalf("marshal error: %v", err)
+			}
+
+			if !bytes.Equal(result, data) {
+				t.Errorf("incorrect round trip")
+				t.Logf("%v", string(result))
+				t.Logf("%v", string(data))
+			}
+		})
+	}
+}
+
+func TestFeatureStringID(t *testing.T) {
+	f := &Feature{ID: "abcd"}
+	if v, ok := f.StringID(); !ok || v != "abcd" {
+		t.Errorf("should return string id: %v %v", v, ok)
+	}
+
+	f.ID = 123.0
+	if _, ok := f.StringID(); ok {
+		t.Errorf("should not return number as string id")
+	}
+
+	f.ID = nil
+	if _, ok := f.StringID(); ok {
+		t.Errorf("should not return nil as string id")
+	}
+}
+
+func TestFeatureIntID(t *testing.T) {
+	cases := []struct {
+		name     string
+		id       interface{}
+		expected int64
+		ok       bool
+	}{
+		{name: "int", id: 123, expected: 123, ok: true},
+		{name: "int64", id: int64(-123), expected: -123, ok: true},
+		{name: "float64", id: 123.0, expected: 123, ok: true},
+		{name: "fractional float64", id: 1.5},
+		{name: "json number", id: json.Number("9007199254740993"), expected: 9007199254740993, ok: true},
+		{name: "large json number", id: json.Number("18446744073709551615")},
+		{name: "string", id: "123"},
+		{name: "nil", id: nil},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			f := &Feature{ID: tc.id}
+			v, ok := f.IntID()
+			if ok != tc.ok {
+				t.Errorf("incorrect ok: %v != %v", ok, tc.ok)
+			}
+
+			if v != tc.expected {
+				t.Errorf("incorrect id: %v != %v", v, tc.expected)
+			}
+		})
+	}
+
+	rawJSON := `{"type": "Feature", "id": 9007199254740993, "geometry": null}`
+	f, err := UnmarshalFeature([]byte(rawJSON))
+	if err != nil {
+		t.Fatalf("should unmarshal feature without issue, err %v", err)
+	}
+
+	if v, ok := f.IntID(); !ok || v != 9007199254740993 {
+		t.Errorf("should preserve large integer id: %v %v", v, ok)
+	}
+}
+
 func TestMarshalRing(t *testing.T) {
 	ring := orb.Ring{{0, 0}, {1, 1}, {2, 1}, {0, 0}}
 
@@ -3,6 +3,9 @@ package geojson
 import (
 	"encoding/json"
 	"errors"
+	"fmt"
+	"math"
+
 	"github.com/paulmach/orb"
 )
 
@@ -88,13 +91,92 @@ func (g Geometry) MarshalJSON() ([]byte, error) {
 	return json.Marshal(ng)
 }
 
+// MarshalWithPrecision marshals the geometry into GeoJSON with the coordinates
+// rounded to the given number of decimal places. The input geometry is not modified.
+// Precisions larger than 15 decimals are marshalled without rounding
+// as that is beyond what a float64 can represent.
+func MarshalWithPrecision(g orb.Geometry, decimals int) ([]byte, error) {
+	if decimals < 0 {
+		return nil, errors.New("geojson: precision must be non-negative")
+	}
+
+	if g != nil && decimals <= 15 {
+		g = orb.Round(orb.Clone(g), int(math.Pow10(decimals)))
+	}
+
+	return json.Marshal(NewGeometry(g))
+}
+
+// MarshalRFC7946 marshals the geometry into GeoJSON with the polygon rings
+// following the right-hand rule required by RFC 7946, i.e. outer rings are
+// counter-clockwise and holes are clockwise. This includes polygons inside
+// collections. The input geometry is not modified.
+func MarshalRFC7946(g orb.Geometry) ([]byte, error) {
+	if g != nil {
+		g = orientRFC7946(orb.Clone(g))
+	}
+
+	return json.Marshal(NewGeometry(g))
+}
+
+func orientRFC7946(g orb.Geometry) orb.Geometry {
+	switch g := g.(type) {
+	case orb.Ring:
+		orb.Polygon{g}.Orient(orb.CCW)
+	case orb.Polygon:
+		g.Orient(orb.CCW)
+	case orb.MultiPolygon:
+		g.Orient(orb.CCW)
+	case orb.Collection:
+		for i := range g {
+			g[i] = orientRFC7946(g[i])
+		}
+	}
+
+	return g
+}
+
+// MarshalWithBBox marshals the geometry into GeoJSON with the computed bbox
+// member included, as allowed by RFC 7946. The geometries of a collection
+// also get their own bbox. Empty collections and geometries have no bbox.
+func MarshalWithBBox(g orb.Geometry) ([]byte, error) {
+	if g == nil {
+		return []byte(`null`), nil
+	}
+
+	return json.Marshal(newGeometryWithBBox(g))
+}
+
+func newGeometryWithBBox(g orb.Geometry) *jsonGeometryBBox {
+	jg := &jsonGeometryBBox{}
+	if c, ok := g.(orb.Collection); ok {
+		jg.Type = c.GeoJSONType()
+		jg.Geometries = make([]*jsonGeometryBBox, 0, len(c))
+		for _, cg := range c {
+			if cg != nil {
+				jg.Geometries = append(jg.Geometries, newGeometryWithBBox(cg))
+			}
+		}
+	} else {
+		ng := NewGeometry(g)
+		jg.Type = ng.Type
+		jg.Coordinates = ng.Coordinates
+	}
+
+	if b := g.Bound(); !b.IsEmpty() {
+		jg.BBox = NewBBox(b)
+	}
+
+	return jg
+}
+
 // UnmarshalGeometry decodes the data into a GeoJSON feature.
 // Alternately one can call json.Unmarshal(g) directly for the same result.
 func UnmarshalGeometry(data []byte) (*Geometry, error) {
 	g := &Geometry{}
 	err := json.Unmarshal(data, g)
 	if err != nil {
-		return nil, err
+		return nil, invalidGeometry(err)
 	}
 
 	return g, nil
@@ -105,7 +187,7 @@ func (g *Geometry) UnmarshalJSON(data []byte) error {
 	jg := &jsonGeometry{}
 	err := json.Unmarshal(data, jg)
 	if err != nil {
-		return err
+		return invalidGeometry(err)
 	}
 
 	switch jg.Type {
@@ -139,11 +221,25 @@ func (g *Geometry) UnmarshalJSON(data []byte) error {
 		return ErrInvalidGeometry
 	}
 
+	if err != nil {
+		return fmt.Errorf("%w: %s %v", ErrInvalidGeometry, jg.Type, err)
+	}
+
 	g.Type = g.Geometry().GeoJSONType()
 
 	return nil
 }
 
+// invalidGeometry wraps the error with ErrInvalidGeometry so callers can
+// use errors.Is, errors that are already wrapped are returned as is.
+func invalidGeometry(err error) error {
+	if errors.Is(err, ErrInvalidGeometry) {
+		return err
+	}
+
+	return fmt.Errorf("%w: %v", ErrInvalidGeometry, err)
+}
+
 // A Point is a helper type that will marshal to/from a GeoJSON Point geometry.
 type Point orb.Point
 
@@ -162,12 +258,12 @@ func (p *Point) UnmarshalJSON(data []byte) error {
 	g := &Geometry{}
 	err := json.Unmarshal(data, &g)
 	if err != nil {
-		return err
+		return invalidGeometry(err)
 	}
 
 	point, ok := g.Coordinates.(orb.Point)
 	if !ok {
-		return errors.New("geojson: not a Point type")
+		return fmt.Errorf("%w: not a Point type", ErrInvalidGeometry)
 	}
 
 	*p = Point(point)
@@ -192,12 +288,12 @@ func (mp *MultiPoint) UnmarshalJSON(data []byte) error {
 	g := &Geometry{}
 	err := json.Unmarshal(data, &g)
 	if err != nil {
-		return err
+		return invalidGeometry(err)
 	}
 
 	multiPoint, ok := g.Coordinates.(orb.MultiPoint)
 	if !ok {
-		return errors.New("geojson: not a MultiPoint type")
+		return fmt.Errorf("%w: not a MultiPoint type", ErrInvalidGeometry)
 	}
 
 	*mp = MultiPoint(multiPoint)
@@ -222,12 +318,12 @@ func (ls *LineString) UnmarshalJSON(data []byte) error {
 	g := &Geometry{}
 	err := json.Unmarshal(data, &g)
 	if err != nil {
-		return err
+		return invalidGeometry(err)
 	}
 
 	lineString, ok := g.Coordinates.(orb.LineString)
 	if !ok {
-		return errors.New("geojson: not a LineString type")
+		return fmt.Errorf("%w: not a LineString type", ErrInvalidGeometry)
 	}
 
 	*ls = LineString(lineString)
@@ -252,12 +348,12 @@ func (mls *MultiLineString) UnmarshalJSON(data []byte) error {
 	g := &Geometry{}
 	err := json.Unmarshal(data, &g)
 	if err != nil {
-		return err
+		return invalidGeometry(err)
 	}
 
 	multilineString, ok := g.Coordinates.(orb.MultiLineString)
 	if !ok {
-		return errors.New("geojson: not a MultiLineString type")
+		return fmt.Errorf("%w: not a MultiLineString type", ErrInvalidGeometry)
 	}
 
 	*mls = MultiLineString(multilineString)
@@ -282,12 +378,12 @@ func (p *Polygon) UnmarshalJSON(data []byte) error {
 	g := &Geometry{}
 	err := json.Unmarshal(data, &g)
 	if err != nil {
-		return err
+		return invalidGeometry(err)
 	}
 
 	polygon, ok := g.Coordinates.(orb.Polygon)
 	if !ok {
-		return errors.New("geojson: not a Polygon type")
+		return fmt.Errorf("%w: not a Polygon type", ErrInvalidGeometry)
 	}
 
 	*p = Polygon(polygon)
@@ -312,12 +408,12 @@ func (mp *MultiPolygon) UnmarshalJSON(data []byte) error {
 	g := &Geometry{}
 	err := json.Unmarshal(data, &g)
 	if err != nil {
-		return err
+		return invalidGeometry(err)
 	}
 
 	multiPolygon, ok := g.Coordinates.(orb.MultiPolygon)
 	if !ok {
-		return errors.New("geojson: not a MultiPolygon type")
+		return fmt.Errorf("%w: not a MultiPolygon type", ErrInvalidGeometry)
 	}
 
 	*mp = MultiPolygon(multiPolygon)
@@ -336,6 +432,13 @@ type jsonGeometryMarshall struct {
 	Geometries  []*Geometry  `json:"geometries,omitempty"`
 }
 
+type jsonGeometryBBox struct {
+	Type        string              `json:"type"`
+	BBox        BBox                `json:"bbox,omitempty"`
+	Coordinates orb.Geometry        `json:"coordinates,omitempty"`
+	Geometries  []*jsonGeometryBBox `json:"geometries,omitempty"`
+}
+
 type nocopyRawMessage []byte
 
 func (m *nocopyRawMessage) UnmarshalJSON(data []byte) error {
@@ -2,6 +2,7 @@ package geojson
 
 import (
 	"encoding/json"
+	"errors"
 	"reflect"
 	"strings"
 	"testing"
@@ -99,6 +100,174 @@ func TestGeometryMarshal(t *testing.T) {
 	}
 }
 
+func TestMarshalWithBBox(t *testing.T) {
+	cases := []struct {
+		name     string
+		geom     orb.Geometry
+		expected string
+	}{
+		{
+			name:     "point",
+			geom:     orb.Point{1, 2},
+			expected: `{"type":"Point","bbox":[1,2,1,2],"coordinates":[1,2]}`,
+		},
+		{
+			name:     "line string",
+			geom:     orb.LineString{{1, 2}, {3, -4}},
+			expected: `{"type":"LineString","bbox":[1,-4,3,2],"coordinates":[[1,2],[3,-4]]}`,
+		},
+		{
+			name:     "bound",
+			geom:     orb.Bound{Min: orb.Point{0, 0}, Max: orb.Point{1, 1}},
+			expected: `{"type":"Polygon","bbox":[0,0,1,1],"coordinates":[[[0,0],[1,0],[1,1],[0,1],[0,0]]]}`,
+		},
+		{
+			name:     "collection",
+			geom:     orb.Collection{orb.Point{1, 2}, orb.MultiPoint{{3, 4}, {5, 0}}},
+			expected: `{"type":"GeometryCollection","bbox":[1,0,5,4],"geometries":[{"type":"Point","bbox":[1,2,1,2],"coordinates":[1,2]},{"type":"MultiPoint","bbox":[3,0,5,4],"coordinates":[[3,4],[5,0]]}]}`,
+		},
+		{
+			name:     "empty collection",
+			geom:     orb.Collection{},
+			expected: `{"type":"GeometryCollection"}`,
+		},
+		{
+			name:     "nil",
+			geom:     nil,
+			expected: `null`,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			data, err := MarshalWithBBox(tc.geom)
+			if err != nil {
+				t.Fatalf("marshal error: %v", err)
+			}
+
+			if string(data) != tc.expected {
+				t.Errorf("incorrect json: %v != %v", string(data), tc.expected)
+			}
+		})
+	}
+
+	// the result can be read back
+	data, _ := MarshalWithBBox(orb.LineString{{1, 2}, {3, 4}})
+	g, err := UnmarshalGeometry(data)
+	if err != nil {
+		t.Fatalf("unmarshal error: %v", err)
+	}
+
+	if !orb.Equal(g.Geometry(), orb.LineString{{1, 2}, {3, 4}}) {
+		t.Errorf("incorrect geometry: %v", g.Geometry())
+	}
+}
+
+func TestMarshalRFC7946(t *testing.T) {
+	// clockwise outer ring with a counter-clockwise hole
+	p := orb.Polygon{
+		{{0, 0}, {0, 4}, {4, 4}, {4, 0}, {0, 0}},
+		{{1, 1}, {2, 1}, {2, 2}, {1, 2}, {1, 1}},
+	}
+	original := p.Clone()
+
+	data, err := MarshalRFC7946(p)
+	if err != nil {
+		t.Fatalf("marshal error: %v", err)
+	}
+
+	expected := `{"type":"Polygon","coordinates":[[[0,0],[4,0],[4,4],[0,4],[0,0]],[[1,1],[1,2],[2,2],[2,1],[1,1]]]}`
+	if string(data) != expected {
+		t.Errorf("incorrect json: %v != %v", string(data), expected)
+	}
+
+	if !p.Equal(original) {
+		t.Errorf("should not modify input: %v", p)
+	}
+
+	c := orb.Collection{orb.Point{1, 2}, orb.MultiPolygon{p}, orb.Ring(p[0])}
+	data, err = MarshalRFC7946(c)
+	if err != nil {
+		t.Fatalf("marshal error: %v", err)
+	}
+
+	g, err := UnmarshalGeometry(data)
+	if err != nil {
+		t.Fatalf("unmarshal error: %v", err)
+	}
+
+	result := g.Geometry().(orb.Collection)
+	if !result[0].(orb.Point).Equal(orb.Point{1, 2}) {
+		t.Errorf("incorrect point: %v", result[0])
+	}
+
+	if o := result[1].(orb.MultiPolygon)[0][0].Orientation(); o != orb.CCW {
+		t.Errorf("outer ring should be CCW: %v", o)
+	}
+
+	if o := result[1].(orb.MultiPolygon)[0][1].Orientation(); o != orb.CW {
+		t.Errorf("hole should be CW: %v", o)
+	}
+
+	if o := result[2].(orb.Polygon)[0].Orientation(); o != orb.CCW {
+		t.Errorf("ring should be CCW: %v", o)
+	}
+
+	if !p.Equal(original) {
+		t.Errorf("should not modify input: %v", p)
+	}
+
+	data, err = MarshalRFC7946(nil)
+	if err != nil {
+		t.Fatalf("marshal error: %v", err)
+	}
+
+	if string(data) != `null` {
+		t.Errorf("nil geometry should marshal as null: %v", string(data))
+	}
+}
+
+func TestMarshalWithPrecision(t *testing.T) {
+	ls := orb.LineString{{1.123456789, -2.987654321}, {3.5, 4}}
+
+	data, err := MarshalWithPrecision(ls, 3)
+	if err != nil {
+		t.Fatalf("marshal error: %v", err)
+	}
+
+	expected := `{"type":"LineString","coordinates":[[1.123,-2.988],[3.5,4]]}`
+	if string(data) != expected {
+		t.Errorf("incorrect json: %v != %v", string(data), expected)
+	}
+
+	if !ls.Equal(orb.LineString{{1.123456789, -2.987654321}, {3.5, 4}}) {
+		t.Errorf("should not modify input: %v", ls)
+	}
+
+	data, err = MarshalWithPrecision(orb.Point{1.6, 2.4}, 0)
+	if err != nil {
+		t.Fatalf("marshal error: %v", err)
+	}
+
+	if v := `{"type":"Point","coordinates":[2,2]}`; string(data) != v {
+		t.Errorf("incorrect json: %v != %v", string(data), v)
+	}
+
+	data, err = MarshalWithPrecision(nil, 6)
+	if err != nil {
+		t.Fatalf("marshal error: %v", err)
+	}
+
+	if string(data) != `null` {
+		t.Errorf("nil geometry should marshal as null: %v", string(data))
+	}
+
+	_, err = MarshalWithPrecision(ls, -1)
+	if err == nil {
+		t.Errorf("should return error for negative precision")
+	}
+}
+
 func TestGeometryUnmarshal(t *testing.T) {
 	cases := []struct {
 		name string
@@ -174,14 +343,20 @@ func TestGeometryUnmarshal(t *testing.T) {
 
 	// invalid json
 	_, err = UnmarshalGeometry([]byte(`{"type": "arc",`)) // truncated
-	if err == nil {
-		t.Errorf("should return error for invalid json")
+	if !errors.Is(err, ErrInvalidGeometry) {
+		t.Errorf("should return invalid geometry for invalid json: %v", err)
 	}
 
 	g := &Geometry{}
 	err = g.UnmarshalJSON([]byte(`{"type": "arc",`)) // truncated
-	if err == nil {
-		t.Errorf("should return error for invalid json")
+	if !errors.Is(err, ErrInvalidGeometry) {
+		t.Errorf("should return invalid geometry for invalid json: %v", err)
+	}
+
+	// invalid coordinates
+	_, err = UnmarshalGeometry([]byte(`{"type": "Point", "coordinates": "a"}`))
+	if !errors.Is(err, ErrInvalidGeometry) {
+		t.Errorf("should return invalid geometry for invalid coordinates: %v", err)
 	}
 
 	// invalid type (null)
@@ -195,6 +370,30 @@ func TestGeometryUnmarshal(t *testing.T) {
 	}
 }
 
+func TestHelperTypes_errors(t *testing.T) {
+	data := []byte(`{"type": "GeometryCollection", "geometries": []}`)
+	helpers := []json.Unmarshaler{
+		&Point{},
+		&MultiPoint{},
+		&LineString{},
+		&MultiLineString{},
+		&Polygon{},
+		&MultiPolygon{},
+	}
+
+	for _, h := range helpers {
+		err := json.Unmarshal(data, h)
+		if !errors.Is(err, ErrInvalidGeometry) {
+			t.Errorf("%T: should return invalid geometry for wrong type: %v", h, err)
+		}
+
+		err = h.UnmarshalJSON([]byte(`{"type":`))
+		if !errors.Is(err, ErrInvalidGeometry) {
+			t.Errorf("%T: should return invalid geometry for invalid json: %v", h, err)
+		}
+	}
+}
+
 func TestHelperTypes(t *testing.T) {
 	// This test makes sure the marshal-unmarshal loop does the same thing.
 	// The code and types here are complicated to avoid duplicate code.
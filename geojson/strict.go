@@ -0,0 +1,226 @@
+package geojson
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/paulmach/orb"
+)
+
+// UnmarshalStrict decodes the GeoJSON geometry, returning an error if it is
+// malformed instead of a partial geometry. Positions must have 2 or 3 numbers,
+// line strings at least 2 positions, and polygon rings at least 4 positions
+// with the first and last being the same. The returned errors wrap
+// ErrInvalidGeometry and describe the problem. Use UnmarshalGeometry
+// or json.Unmarshal for the permissive behavior.
+func UnmarshalStrict(data []byte) (orb.Geometry, error) {
+	jg := &strictGeometry{}
+	err := json.Unmarshal(data, jg)
+	if err != nil {
+		return nil, invalidGeometry(err)
+	}
+
+	if jg.Type == "GeometryCollection" {
+		c := make(orb.Collection, 0, len(jg.Geometries))
+		for i, raw := range jg.Geometries {
+			g, err := UnmarshalStrict(raw)
+			if err != nil {
+				return nil, fmt.Errorf("%w: geometries[%d]: %s", ErrInvalidGeometry, i, trimInvalid(err))
+			}
+			c = append(c, g)
+		}
+
+		return c, nil
+	}
+
+	if len(jg.Coordinates) == 0 || string(jg.Coordinates) == "null" {
+		return nil, fmt.Errorf("%w: %s missing coordinates", ErrInvalidGeometry, typeName(jg.Type))
+	}
+
+	var coords interface{}
+	err = json.Unmarshal(jg.Coordinates, &coords)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidGeometry, err)
+	}
+
+	var g orb.Geometry
+	switch jg.Type {
+	case "Point":
+		g, err = strictPosition(coords, "coordinates")
+	case "MultiPoint":
+		g, err = strictMultiPoint(coords, "coordinates")
+	case "LineString":
+		g, err = strictLineString(coords, "coordinates")
+	case "MultiLineString":
+		g, err = strictMultiLineString(coords, "coordinates")
+	case "Polygon":
+		g, err = strictPolygon(coords, "coordinates")
+	case "MultiPolygon":
+		g, err = strictMultiPolygon(coords, "coordinates")
+	default:
+		return nil, fmt.Errorf("%w: unknown type %s", ErrInvalidGeometry, typeName(jg.Type))
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s %v", ErrInvalidGeometry, jg.Type, err)
+	}
+
+	return g, nil
+}
+
+type strictGeometry struct {
+	Type        string            `json:"type"`
+	Coordinates json.RawMessage   `json:"coordinates"`
+	Geometries  []json.RawMessage `json:"geometries"`
+}
+
+func strictArray(v interface{}, path string) ([]interface{}, error) {
+	a, ok := v.([]interface{})
+	if !ok {
+		return nil, fmt.Errorf("%s: expected an array", path)
+	}
+
+	return a, nil
+}
+
+func strictPosition(v interface{}, path string) (orb.Point, error) {
+	a, err := strictArray(v, path)
+	if err != nil {
+		return orb.Point{}, err
+	}
+
+	if len(a) != 2 && len(a) != 3 {
+		return orb.Point{}, fmt.Errorf("%s: position must have 2 or 3 numbers, got %d", path, len(a))
+	}
+
+	// the optional elevation is checked but not kept
+	var p orb.Point
+	for i := range a {
+		f, ok := a[i].(float64)
+		if !ok {
+			return orb.Point{}, fmt.Errorf("%s[%d]: expected a number", path, i)
+		}
+
+		if i < len(p) {
+			p[i] = f
+		}
+	}
+
+	return p, nil
+}
+
+func strictMultiPoint(v interface{}, path string) (orb.MultiPoint, error) {
+	a, err := strictArray(v, path)
+	if err != nil {
+		return nil, err
+	}
+
+	mp := make(orb.MultiPoint, 0, len(a))
+	for i, c := range a {
+		p, err := strictPosition(c, fmt.Sprintf("%s[%d]", path, i))
+		if err != nil {
+			return nil, err
+		}
+		mp = append(mp, p)
+	}
+
+	return mp, nil
+}
+
+func strictLineString(v interface{}, path string) (orb.LineString, error) {
+	mp, err := strictMultiPoint(v, path)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(mp) < 2 {
+		return nil, fmt.Errorf("%s: line string must have at least 2 positions, got %d", path, len(mp))
+	}
+
+	return orb.LineString(mp), nil
+}
+
+func strictMultiLineString(v interface{}, path string) (orb.MultiLineString, error) {
+	a, err := strictArray(v, path)
+	if err != nil {
+		return nil, err
+	}
+
+	mls := make(orb.MultiLineString, 0, len(a))
+	for i, c := range a {
+		ls, err := strictLineString(c, fmt.Sprintf("%s[%d]", path, i))
+		if err != nil {
+			return nil, err
+		}
+		mls = append(mls, ls)
+	}
+
+	return mls, nil
+}
+
+func strictPolygon(v interface{}, path string) (orb.Polygon, error) {
+	a, err := strictArray(v, path)
+	if err != nil {
+		return nil, err
+	}
+
+	p := make(orb.Polygon, 0, len(a))
+	for i, c := range a {
+		rpath := fmt.Sprintf("%s[%d]", path, i)
+
+		mp, err := strictMultiPoint(c, rpath)
+		if err != nil {
+			return nil, err
+		}
+
+		if len(mp) < 4 {
+			return nil, fmt.Errorf("%s: ring must have at least 4 positions, got %d", rpath, len(mp))
+		}
+
+		if mp[0] != mp[len(mp)-1] {
+			return nil, fmt.Errorf("%s: ring is not closed", rpath)
+		}
+
+		p = append(p, orb.Ring(mp))
+	}
+
+	return p, nil
+}
+
+func strictMultiPolygon(v interface{}, path string) (orb.MultiPolygon, error) {
+	a, err := strictArray(v, path)
+	if err != nil {
+		return nil, err
+	}
+
+	mp := make(orb.MultiPolygon, 0, len(a))
+	for i, c := range a {
+		p, err := strictPolygon(c, fmt.Sprintf("%s[%d]", path, i))
+		if err != nil {
+			return nil, err
+		}
+		mp = append(mp, p)
+	}
+
+	return mp, nil
+}
+
+func typeName(t string) string {
+	if t == "" {
+		return `""`
+	}
+
+	return t
+}
+
+// trimInvalid removes the ErrInvalidGeometry prefix from a nested error
+// so it's not repeated when wrapped again.
+func trimInvalid(err error) string {
+	s := err.Error()
+	prefix := ErrInvalidGeometry.Error() + ": "
+	if len(s) > len(prefix) && s[:len(prefix)] == prefix {
+		return s[len(prefix):]
+	}
+
+	return s
+}
@@ -0,0 +1,157 @@
+package geojson
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/paulmach/orb"
+)
+
+func TestUnmarshalStrict(t *testing.T) {
+	cases := []struct {
+		name     string
+		data     string
+		expected orb.Geometry
+	}{
+		{
+			name:     "point",
+			data:     `{"type":"Point","coordinates":[1,2]}`,
+			expected: orb.Point{1, 2},
+		},
+		{
+			name:     "point with altitude",
+			data:     `{"type":"Point","coordinates":[1,2,3]}`,
+			expected: orb.Point{1, 2},
+		},
+		{
+			name:     "line string",
+			data:     `{"type":"LineString","coordinates":[[1,2],[3,4]]}`,
+			expected: orb.LineString{{1, 2}, {3, 4}},
+		},
+		{
+			name:     "polygon",
+			data:     `{"type":"Polygon","coordinates":[[[0,0],[1,0],[1,1],[0,0]]]}`,
+			expected: orb.Polygon{{{0, 0}, {1, 0}, {1, 1}, {0, 0}}},
+		},
+		{
+			name:     "multi polygon",
+			data:     `{"type":"MultiPolygon","coordinates":[[[[0,0],[1,0],[1,1],[0,0]]]]}`,
+			expected: orb.MultiPolygon{{{{0, 0}, {1, 0}, {1, 1}, {0, 0}}}},
+		},
+		{
+			name:     "empty multi point",
+			data:     `{"type":"MultiPoint","coordinates":[]}`,
+			expected: orb.MultiPoint{},
+		},
+		{
+			name:     "collection",
+			data:     `{"type":"GeometryCollection","geometries":[{"type":"Point","coordinates":[1,2]},{"type":"MultiLineString","coordinates":[[[1,2],[3,4]]]}]}`,
+			expected: orb.Collection{orb.Point{1, 2}, orb.MultiLineString{{{1, 2}, {3, 4}}}},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			g, err := UnmarshalStrict([]byte(tc.data))
+			if err != nil {
+				t.Fatalf("unmarshal error: %v", err)
+			}
+
+			if !orb.Equal(g, tc.expected) {
+				t.Errorf("incorrect geometry: %v != %v", g, tc.expected)
+			}
+		})
+	}
+}
+
+func TestUnmarshalStrict_errors(t *testing.T) {
+	cases := []struct {
+		name string
+		data string
+		err  string
+	}{
+		{
+			name: "one number position",
+			data: `{"type":"Point","coordinates":[1]}`,
+			err:  "geojson: invalid geometry: Point coordinates: position must have 2 or 3 numbers, got 1",
+		},
+		{
+			name: "too many numbers",
+			data: `{"type":"MultiPoint","coordinates":[[1,2],[1,2,3,4]]}`,
+			err:  "geojson: invalid geometry: MultiPoint coordinates[1]: position must have 2 or 3 numbers, got 4",
+		},
+		{
+			name: "not a number",
+			data: `{"type":"Point","coordinates":[1,null]}`,
+			err:  "geojson: invalid geometry: Point coordinates[1]: expected a number",
+		},
+		{
+			name: "altitude not a number",
+			data: `{"type":"Point","coordinates":[1,2,"x"]}`,
+			err:  "geojson: invalid geometry: Point coordinates[2]: expected a number",
+		},
+		{
+			name: "invalid json",
+			data: `{"type":"Point","coordinates":[1,2]`,
+			err:  "geojson: invalid geometry: unexpected end of JSON input",
+		},
+		{
+			name: "wrong nesting",
+			data: `{"type":"LineString","coordinates":[1,2]}`,
+			err:  "geojson: invalid geometry: LineString coordinates[0]: expected an array",
+		},
+		{
+			name: "short line string",
+			data: `{"type":"MultiLineString","coordinates":[[[1,2]]]}`,
+			err:  "geojson: invalid geometry: MultiLineString coordinates[0]: line string must have at least 2 positions, got 1",
+		},
+		{
+			name: "short ring",
+			data: `{"type":"Polygon","coordinates":[[[0,0],[1,0],[0,0]]]}`,
+			err:  "geojson: invalid geometry: Polygon coordinates[0]: ring must have at least 4 positions, got 3",
+		},
+		{
+			name: "ring not closed",
+			data: `{"type":"MultiPolygon","coordinates":[[[[0,0],[1,0],[1,1],[0,0]]],[[[0,0],[1,0],[1,1],[0,1]]]]}`,
+			err:  "geojson: invalid geometry: MultiPolygon coordinates[1][0]: ring is not closed",
+		},
+		{
+			name: "missing coordinates",
+			data: `{"type":"Point"}`,
+			err:  "geojson: invalid geometry: Point missing coordinates",
+		},
+		{
+			name: "unknown type",
+			data: `{"type":"Circle","coordinates":[1,2]}`,
+			err:  "geojson: invalid geometry: unknown type Circle",
+		},
+		{
+			name: "invalid collection member",
+			data: `{"type":"GeometryCollection","geometries":[{"type":"Point","coordinates":[1,2]},{"type":"Point","coordinates":[1]}]}`,
+			err:  "geojson: invalid geometry: geometries[1]: Point coordinates: position must have 2 or 3 numbers, got 1",
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := UnmarshalStrict([]byte(tc.data))
+			if err == nil {
+				t.Fatalf("expected error")
+			}
+
+			if err.Error() != tc.err {
+				t.Errorf("incorrect error: %v", err)
+			}
+
+			if !errors.Is(err, ErrInvalidGeometry) {
+				t.Errorf("should wrap ErrInvalidGeometry: %v", err)
+			}
+		})
+	}
+
+	// the permissive unmarshal still accepts these
+	g, err := UnmarshalGeometry([]byte(`{"type":"Polygon","coordinates":[[[0,0],[1,0],[0,0]]]}`))
+	if err != nil || g.Type != "Polygon" {
+		t.Errorf("permissive unmarshal should work: %v %v", g, err)
+	}
+}
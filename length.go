@@ -0,0 +1,66 @@
+package orb
+
+import "fmt"
+
+// Length returns the length of the geometry using planar distance. Points
+// have zero length, rings and polygons return their perimeter including any
+// holes and multi-geometries and collections return the sum of their parts.
+// The length of a bound is its perimeter. Rings are measured along the
+// points as given, so no closing segment is added if they are not closed.
+// Use the geo sub-package for lengths on the earth's surface.
+func Length(g Geometry) float64 {
+	if g == nil {
+		return 0
+	}
+
+	switch g := g.(type) {
+	case Point, MultiPoint:
+		return 0
+	case LineString:
+		return lineStringLength(g)
+	case MultiLineString:
+		sum := 0.0
+		for _, ls := range g {
+			sum += lineStringLength(ls)
+		}
+		return sum
+	case Ring:
+		return lineStringLength(LineString(g))
+	case Polygon:
+		return polygonLength(g)
+	case MultiPolygon:
+		sum := 0.0
+		for _, p := range g {
+			sum += polygonLength(p)
+		}
+		return sum
+	case Collection:
+		sum := 0.0
+		for _, c := range g {
+			sum += Length(c)
+		}
+		return sum
+	case Bound:
+		return 2 * ((g.Max[0] - g.Min[0]) + (g.Max[1] - g.Min[1]))
+	}
+
+	panic(fmt.Sprintf("geometry type not supported: %T", g))
+}
+
+func lineStringLength(ls LineString) float64 {
+	sum := 0.0
+	for i := 1; i < len(ls); i++ {
+		sum += planarDistance(ls[i-1], ls[i])
+	}
+
+	return sum
+}
+
+func polygonLength(p Polygon) float64 {
+	sum := 0.0
+	for _, r := range p {
+		sum += lineStringLength(LineString(r))
+	}
+
+	return sum
+}
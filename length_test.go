@@ -0,0 +1,33 @@
+package orb
+
+import "testing"
+
+func TestLength(t *testing.T) {
+	square := Ring{{0, 0}, {3, 0}, {3, 3}, {0, 3}, {0, 0}}
+	hole := Ring{{1, 1}, {2, 1}, {2, 2}, {1, 2}, {1, 1}}
+
+	cases := []struct {
+		name     string
+		geom     Geometry
+		expected float64
+	}{
+		{name: "point", geom: Point{1, 2}, expected: 0},
+		{name: "multi point", geom: MultiPoint{{1, 2}, {3, 4}}, expected: 0},
+		{name: "line string", geom: LineString{{0, 0}, {3, 4}, {3, 0}}, expected: 9},
+		{name: "multi line string", geom: MultiLineString{{{0, 0}, {3, 4}}, {{0, 0}, {0, 1}}}, expected: 6},
+		{name: "ring", geom: square, expected: 12},
+		{name: "polygon with hole", geom: Polygon{square, hole}, expected: 16},
+		{name: "multi polygon", geom: MultiPolygon{{square}, {hole}}, expected: 16},
+		{name: "bound", geom: Bound{Min: Point{0, 0}, Max: Point{2, 3}}, expected: 10},
+		{name: "collection", geom: Collection{Point{1, 1}, LineString{{0, 0}, {3, 4}}, Polygon{hole}}, expected: 9},
+		{name: "nil", geom: nil, expected: 0},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			if v := Length(tc.geom); v != tc.expected {
+				t.Errorf("incorrect length: %v != %v", v, tc.expected)
+			}
+		})
+	}
+}
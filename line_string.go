@@ -1,5 +1,7 @@
 package orb
 
+import "math"
+
 // LineString represents a set of points to be thought of as a polyline.
 type LineString []Point
 
@@ -22,6 +24,51 @@ func (ls LineString) Reverse() {
 	}
 }
 
+// Map returns a new line string with the function applied to each point.
+// The original line string is not modified.
+func (ls LineString) Map(fn func(Point) Point) LineString {
+	return LineString(MultiPoint(ls).Map(fn))
+}
+
+// Chunk splits the line string into pieces of at most maxPoints points.
+// Consecutive chunks share the boundary vertex so they reconnect.
+// The chunks are sub-slices of the original line string, capped at their
+// length so appending to one does not overwrite the next. If maxPoints is
+// less than 2 the whole line is returned as the only chunk.
+func (ls LineString) Chunk(maxPoints int) []LineString {
+	if maxPoints < 2 || len(ls) <= maxPoints {
+		return []LineString{ls}
+	}
+
+	step := maxPoints - 1
+	chunks := make([]LineString, 0, (len(ls)-2)/step+1)
+	for i := 0; i < len(ls)-1; i += step {
+		end := i + maxPoints
+		if end > len(ls) {
+			end = len(ls)
+		}
+
+		chunks = append(chunks, ls[i:end:end])
+	}
+
+	return chunks
+}
+
+// EndDirection returns the midpoint of the last segment and its direction as
+// the planar angle in radians, counter-clockwise from the positive x axis.
+// This is useful for placing an arrow at the end of the line.
+// Line strings with less than 2 points return zero values.
+func (ls LineString) EndDirection() (Point, float64) {
+	if len(ls) < 2 {
+		return Point{}, 0
+	}
+
+	a, b := ls[len(ls)-2], ls[len(ls)-1]
+	mid := Point{(a[0] + b[0]) / 2, (a[1] + b[1]) / 2}
+
+	return mid, math.Atan2(b[1]-a[1], b[0]-a[0])
+}
+
 // Bound returns a rect around the line string. Uses rectangular coordinates.
 func (ls LineString) Bound() Bound {
 	return MultiPoint(ls).Bound()
@@ -1,6 +1,7 @@
 package orb
 
 import (
+	"math"
 	"testing"
 )
 
@@ -47,3 +48,111 @@ func TestLineStringReverse(t *testing.T) {
 		})
 	}
 }
+
+func TestLineStringMap(t *testing.T) {
+	ls := LineString{{1, 2}, {3, 4}}
+	result := ls.Map(func(p Point) Point {
+		return Point{p[1], p[0]}
+	})
+
+	if expected := (LineString{{2, 1}, {4, 3}}); !result.Equal(expected) {
+		t.Errorf("incorrect result: %v != %v", result, expected)
+	}
+
+	if !ls.Equal(LineString{{1, 2}, {3, 4}}) {
+		t.Errorf("should not modify original: %v", ls)
+	}
+}
+
+func TestLineStringChunk(t *testing.T) {
+	ls := LineString{{0, 0}, {1, 0}, {2, 0}, {3, 0}, {4, 0}, {5, 0}}
+
+	cases := []struct {
+		name      string
+		maxPoints int
+		expected  []LineString
+	}{
+		{
+			name:      "three points",
+			maxPoints: 3,
+			expected: []LineString{
+				{{0, 0}, {1, 0}, {2, 0}},
+				{{2, 0}, {3, 0}, {4, 0}},
+				{{4, 0}, {5, 0}},
+			},
+		},
+		{
+			name:      "two points",
+			maxPoints: 2,
+			expected: []LineString{
+				{{0, 0}, {1, 0}}, {{1, 0}, {2, 0}}, {{2, 0}, {3, 0}},
+				{{3, 0}, {4, 0}}, {{4, 0}, {5, 0}},
+			},
+		},
+		{
+			name:      "exact fit",
+			maxPoints: 6,
+			expected:  []LineString{ls},
+		},
+		{
+			name:      "shared end",
+			maxPoints: 4,
+			expected: []LineString{
+				{{0, 0}, {1, 0}, {2, 0}, {3, 0}},
+				{{3, 0}, {4, 0}, {5, 0}},
+			},
+		},
+		{
+			name:      "less than 2",
+			maxPoints: 1,
+			expected:  []LineString{ls},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			chunks := ls.Chunk(tc.maxPoints)
+			if len(chunks) != len(tc.expected) {
+				t.Fatalf("incorrect number of chunks: %v", chunks)
+			}
+
+			for i := range chunks {
+				if !chunks[i].Equal(tc.expected[i]) {
+					t.Errorf("incorrect chunk %d: %v != %v", i, chunks[i], tc.expected[i])
+				}
+			}
+		})
+	}
+
+	// appending to a chunk should not change the next one
+	chunks := ls.Chunk(3)
+	_ = append(chunks[0], Point{10, 10})
+	if !chunks[1].Equal(LineString{{2, 0}, {3, 0}, {4, 0}}) {
+		t.Errorf("next chunk was modified: %v", chunks[1])
+	}
+}
+
+func TestLineStringEndDirection(t *testing.T) {
+	ls := LineString{{0, 0}, {5, 5}, {5, 7}}
+	mid, angle := ls.EndDirection()
+
+	if !mid.Equal(Point{5, 6}) {
+		t.Errorf("incorrect midpoint: %v", mid)
+	}
+
+	if math.Abs(angle-math.Pi/2) > 1e-10 {
+		t.Errorf("incorrect angle: %v != %v", angle, math.Pi/2)
+	}
+
+	_, angle = LineString{{1, 1}, {0, 0}}.EndDirection()
+	if math.Abs(angle+3*math.Pi/4) > 1e-10 {
+		t.Errorf("incorrect angle: %v != %v", angle, -3*math.Pi/4)
+	}
+
+	for _, ls := range []LineString{nil, {{1, 2}}} {
+		mid, angle := ls.EndDirection()
+		if !mid.Equal(Point{}) || angle != 0 {
+			t.Errorf("should return zero values: %v %v", mid, angle)
+		}
+	}
+}
@@ -55,6 +55,74 @@ func At(ll orb.Point, z Zoom) Tile {
 	return t
 }
 
+// TilesForGeometry returns the tiles at the given zoom that overlap the bound
+// of the geometry, ordered by row and then column. Each tile is included once.
+// To cover the actual shape of the geometry see the tilecover sub-package.
+func TilesForGeometry(g orb.Geometry, z Zoom) Tiles {
+	if g == nil {
+		return nil
+	}
+
+	b := g.Bound()
+	if b.IsEmpty() {
+		return nil
+	}
+
+	minX, minY, maxX, maxY := tileRange(b, z)
+
+	tiles := make(Tiles, 0, (maxX-minX+1)*(maxY-minY+1))
+	for y := minY; y <= maxY; y++ {
+		for x := minX; x <= maxX; x++ {
+			tiles = append(tiles, Tile{X: x, Y: y, Z: z})
+		}
+	}
+
+	return tiles
+}
+
+// EachTile calls f for each tile at the given zoom that overlaps the bound,
+// ordered by row and then column, and stops if f returns false.
+// Unlike TilesForGeometry no slice is allocated, making it suitable
+// for large bounds at high zoom.
+func EachTile(b orb.Bound, z Zoom, f func(Tile) bool) {
+	if b.IsEmpty() {
+		return
+	}
+
+	minX, minY, maxX, maxY := tileRange(b, z)
+	for y := minY; y <= maxY; y++ {
+		for x := minX; x <= maxX; x++ {
+			if !f(Tile{X: x, Y: y, Z: z}) {
+				return
+			}
+		}
+	}
+}
+
+// tileRange returns the inclusive range of tile indexes overlapping the bound.
+func tileRange(b orb.Bound, z Zoom) (minX, minY, maxX, maxY uint32) {
+	max := uint32(1<<z) - 1
+	min := Fraction(orb.Point{b.Min[0], b.Max[1]}, z)
+	maxf := Fraction(orb.Point{b.Max[0], b.Min[1]}, z)
+
+	minX, maxX = clampTileIndex(min[0], max), clampTileIndex(maxf[0], max)
+	minY, maxY = clampTileIndex(min[1], max), clampTileIndex(maxf[1], max)
+
+	return minX, minY, maxX, maxY
+}
+
+func clampTileIndex(f float64, max uint32) uint32 {
+	if f <= 0 {
+		return 0
+	}
+
+	if f >= float64(max) {
+		return max
+	}
+
+	return uint32(f)
+}
+
 // FromQuadkey creates the tile from the quadkey.
 func FromQuadkey(k uint64, z Zoom) Tile {
 	t := Tile{Z: z}
@@ -111,6 +179,19 @@ func (t Tile) Bound(tileBuffer ...float64) orb.Bound {
 	}
 }
 
+// BoundProjected returns the bound of the tile in EPSG:3857 web mercator
+// meters. This is the extent used to scale projected coordinates into
+// tile-local pixels when encoding vector tiles.
+func (t Tile) BoundProjected() orb.Bound {
+	half := math.Pi * orb.EarthRadius
+	size := 2 * half / float64(uint64(1)<<t.Z)
+
+	return orb.Bound{
+		Min: orb.Point{-half + float64(t.X)*size, half - float64(t.Y+1)*size},
+		Max: orb.Point{-half + float64(t.X+1)*size, half - float64(t.Y)*size},
+	}
+}
+
 // Center returns the center of the tile.
 func (t Tile) Center() orb.Point {
 	return t.Bound(0).Center()
@@ -3,6 +3,7 @@ package maptile
 import (
 	"fmt"
 	"math"
+	"reflect"
 	"testing"
 
 	"github.com/paulmach/orb"
@@ -124,6 +125,32 @@ func TestTileBound(t *testing.T) {
 	}
 }
 
+func TestTileBoundProjected(t *testing.T) {
+	half := math.Pi * orb.EarthRadius
+
+	expected := orb.Bound{Min: orb.Point{-half, -half}, Max: orb.Point{half, half}}
+	if b := New(0, 0, 0).BoundProjected(); !b.Equal(expected) {
+		t.Errorf("should be full world, got %v", b)
+	}
+
+	expected = orb.Bound{Min: orb.Point{0, -half}, Max: orb.Point{half, 0}}
+	if b := New(1, 1, 1).BoundProjected(); !b.Equal(expected) {
+		t.Errorf("incorrect bound: %v != %v", b, expected)
+	}
+
+	// should match the lon/lat bound projected to mercator
+	tile := New(7, 8, 9)
+	b := tile.Bound()
+	pb := tile.BoundProjected()
+	for _, c := range [][2]orb.Point{{b.Min, pb.Min}, {b.Max, pb.Max}} {
+		x := orb.EarthRadius * c[0][0] * math.Pi / 180
+		y := orb.EarthRadius * math.Log(math.Tan(math.Pi/4+c[0][1]*math.Pi/360))
+		if math.Abs(x-c[1][0]) > 1e-6 || math.Abs(y-c[1][1]) > 1e-6 {
+			t.Errorf("incorrect corner: %v != %v", c[1], orb.Point{x, y})
+		}
+	}
+}
+
 func TestFraction(t *testing.T) {
 	p := Fraction(orb.Point{-180, 0}, 30)
 	if p[0] != 0 {
@@ -290,3 +317,86 @@ func BenchmarkSharedParent_DifferentZoom(b *testing.B) {
 		one.SharedParent(two)
 	}
 }
+
+func TestTilesForGeometry(t *testing.T) {
+	z := Zoom(4)
+	ls := orb.LineString{{-10, 10}, {30, -20}, {25, 30}}
+
+	tiles := TilesForGeometry(ls, z)
+
+	// brute force the expected tiles
+	expected := 0
+	max := uint32(1 << z)
+	for x := uint32(0); x < max; x++ {
+		for y := uint32(0); y < max; y++ {
+			tb := New(x, y, z).Bound()
+			b := ls.Bound()
+			if tb.Max[0] > b.Min[0] && tb.Min[0] < b.Max[0] &&
+				tb.Max[1] > b.Min[1] && tb.Min[1] < b.Max[1] {
+				expected++
+			}
+		}
+	}
+
+	if len(tiles) != expected {
+		t.Errorf("incorrect number of tiles: %d != %d", len(tiles), expected)
+	}
+
+	seen := map[Tile]bool{}
+	for _, tile := range tiles {
+		if seen[tile] {
+			t.Errorf("duplicate tile: %v", tile)
+		}
+		seen[tile] = true
+
+		if !tile.Bound().Intersects(ls.Bound()) {
+			t.Errorf("tile does not overlap: %v", tile)
+		}
+	}
+
+	// whole world
+	tiles = TilesForGeometry(orb.Bound{Min: orb.Point{-180, -90}, Max: orb.Point{180, 90}}, 2)
+	if len(tiles) != 16 {
+		t.Errorf("should cover the whole world: %d", len(tiles))
+	}
+
+	// point
+	tiles = TilesForGeometry(orb.Point{-87.65005229999997, 41.850033}, 10)
+	if len(tiles) != 1 || tiles[0] != At(orb.Point{-87.65005229999997, 41.850033}, 10) {
+		t.Errorf("incorrect point tile: %v", tiles)
+	}
+
+	if v := TilesForGeometry(nil, 10); v != nil {
+		t.Errorf("nil geometry should have no tiles: %v", v)
+	}
+
+	if v := TilesForGeometry(orb.LineString{}, 10); v != nil {
+		t.Errorf("empty geometry should have no tiles: %v", v)
+	}
+}
+
+func TestEachTile(t *testing.T) {
+	b := orb.LineString{{-10, 10}, {30, -20}, {25, 30}}.Bound()
+
+	var tiles Tiles
+	EachTile(b, 4, func(tile Tile) bool {
+		tiles = append(tiles, tile)
+		return true
+	})
+
+	expected := TilesForGeometry(b, 4)
+	if !reflect.DeepEqual(tiles, expected) {
+		t.Errorf("should match tiles for geometry: %v != %v", tiles, expected)
+	}
+
+	// stops early
+	count := 0
+	EachTile(b, 4, func(tile Tile) bool {
+		count++
+		return count < 3
+	})
+
+	if count != 3 {
+		t.Errorf("should stop when false is returned: %d", count)
+	}
+}
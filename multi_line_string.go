@@ -56,3 +56,76 @@ func (mls MultiLineString) Clone() MultiLineString {
 
 	return nmls
 }
+
+// MergeLines joins line strings where the last point of one line is exactly
+// equal to the first point of another, returning the merged lines. Lines are
+// only joined in their given direction and lines that can not be connected
+// are returned separately. Empty line strings are dropped. The input is not
+// modified, the merged lines are new slices.
+func MergeLines(lines MultiLineString) MultiLineString {
+	// the unused lines starting at each point, in input order
+	starts := make(map[Point][]int, len(lines))
+	// the number of other lines ending at each point
+	ends := make(map[Point]int, len(lines))
+	for i, ls := range lines {
+		if len(ls) == 0 {
+			continue
+		}
+
+		starts[ls[0]] = append(starts[ls[0]], i)
+		ends[ls[len(ls)-1]]++
+	}
+
+	used := make([]bool, len(lines))
+	next := func(p Point) int {
+		for _, i := range starts[p] {
+			if !used[i] {
+				return i
+			}
+		}
+		return -1
+	}
+
+	chain := func(i int) LineString {
+		used[i] = true
+		merged := append(LineString(nil), lines[i]...)
+		for {
+			j := next(merged[len(merged)-1])
+			if j == -1 {
+				return merged
+			}
+
+			used[j] = true
+			merged = append(merged, lines[j][1:]...)
+		}
+	}
+
+	var result MultiLineString
+
+	// start with lines that no other line connects into
+	for i, ls := range lines {
+		if len(ls) == 0 || used[i] {
+			continue
+		}
+
+		n := ends[ls[0]]
+		if ls[0] == ls[len(ls)-1] {
+			n--
+		}
+
+		if n == 0 {
+			result = append(result, chain(i))
+		}
+	}
+
+	// the rest form loops or join into chains that were already started
+	for i, ls := range lines {
+		if len(ls) == 0 || used[i] {
+			continue
+		}
+
+		result = append(result, chain(i))
+	}
+
+	return result
+}
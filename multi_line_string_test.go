@@ -105,3 +105,60 @@ func TestMultiLineString_Clone(t *testing.T) {
 		})
 	}
 }
+
+func TestMergeLines(t *testing.T) {
+	cases := []struct {
+		name     string
+		lines    MultiLineString
+		expected MultiLineString
+	}{
+		{
+			name:     "in order",
+			lines:    MultiLineString{{{0, 0}, {1, 0}}, {{1, 0}, {2, 0}}, {{2, 0}, {3, 1}}},
+			expected: MultiLineString{{{0, 0}, {1, 0}, {2, 0}, {3, 1}}},
+		},
+		{
+			name:     "out of order",
+			lines:    MultiLineString{{{2, 0}, {3, 1}}, {{1, 0}, {2, 0}}, {{0, 0}, {1, 0}}},
+			expected: MultiLineString{{{0, 0}, {1, 0}, {2, 0}, {3, 1}}},
+		},
+		{
+			name:     "disconnected",
+			lines:    MultiLineString{{{0, 0}, {1, 0}}, {{5, 5}, {6, 6}}, {{1, 0}, {1, 1}}},
+			expected: MultiLineString{{{0, 0}, {1, 0}, {1, 1}}, {{5, 5}, {6, 6}}},
+		},
+		{
+			name:     "opposite direction is not joined",
+			lines:    MultiLineString{{{0, 0}, {1, 0}}, {{2, 0}, {1, 0}}},
+			expected: MultiLineString{{{0, 0}, {1, 0}}, {{2, 0}, {1, 0}}},
+		},
+		{
+			name:     "loop",
+			lines:    MultiLineString{{{0, 0}, {1, 0}}, {{1, 1}, {0, 0}}, {{1, 0}, {1, 1}}},
+			expected: MultiLineString{{{0, 0}, {1, 0}, {1, 1}, {0, 0}}},
+		},
+		{
+			name:     "branch",
+			lines:    MultiLineString{{{0, 0}, {1, 0}}, {{1, 0}, {2, 0}}, {{1, 0}, {1, 1}}},
+			expected: MultiLineString{{{0, 0}, {1, 0}, {2, 0}}, {{1, 0}, {1, 1}}},
+		},
+		{
+			name:     "empty lines",
+			lines:    MultiLineString{{}, {{0, 0}, {1, 0}}},
+			expected: MultiLineString{{{0, 0}, {1, 0}}},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			input := tc.lines.Clone()
+			if v := MergeLines(tc.lines); !v.Equal(tc.expected) {
+				t.Errorf("incorrect merge: %v != %v", v, tc.expected)
+			}
+
+			if !tc.lines.Equal(input) {
+				t.Errorf("should not modify the input")
+			}
+		})
+	}
+}
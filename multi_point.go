@@ -1,5 +1,11 @@
 package orb
 
+import (
+	"math"
+	"math/rand"
+	"sort"
+)
+
 // A MultiPoint represents a set of points in the 2D Eucledian or Cartesian plane.
 type MultiPoint []Point
 
@@ -25,6 +31,21 @@ func (mp MultiPoint) Clone() MultiPoint {
 	return MultiPoint(points)
 }
 
+// Map returns a new multi point with the function applied to each point.
+// The original points are not modified.
+func (mp MultiPoint) Map(fn func(Point) Point) MultiPoint {
+	if mp == nil {
+		return nil
+	}
+
+	points := make(MultiPoint, len(mp))
+	for i, p := range mp {
+		points[i] = fn(p)
+	}
+
+	return points
+}
+
 // Bound returns a bound around the points. Uses rectangular coordinates.
 func (mp MultiPoint) Bound() Bound {
 	if len(mp) == 0 {
@@ -54,3 +75,139 @@ func (mp MultiPoint) Equal(multiPoint MultiPoint) bool {
 
 	return true
 }
+
+// SamplePoints returns n points chosen uniformly at random from the multi point
+// using reservoir sampling. If there are n or fewer points a copy of all of them
+// is returned. The given source of randomness is used, or the default source
+// of the math/rand package if nil. The input is not modified.
+func SamplePoints(mp MultiPoint, n int, rnd *rand.Rand) MultiPoint {
+	if n <= 0 {
+		return nil
+	}
+
+	if len(mp) <= n {
+		return mp.Clone()
+	}
+
+	intn := rand.Intn
+	if rnd != nil {
+		intn = rnd.Intn
+	}
+
+	result := make(MultiPoint, n)
+	copy(result, mp[:n])
+	for i := n; i < len(mp); i++ {
+		if j := intn(i + 1); j < n {
+			result[j] = mp[i]
+		}
+	}
+
+	return result
+}
+
+// RobustBound returns the bound around the given fraction, between 0 and 1,
+// of the points closest to the average of all the points. For example, with
+// 0.95 the 5% of points farthest from the center, such as bad GPS fixes, are
+// ignored. At least one point is always included, a fraction of 1 or more
+// returns the full bound. An empty multi point returns an empty bound.
+// Distances are computed in the 2d plane.
+func RobustBound(mp MultiPoint, fraction float64) Bound {
+	if len(mp) == 0 {
+		return emptyBound
+	}
+
+	if fraction >= 1 {
+		return mp.Bound()
+	}
+
+	n := int(math.Ceil(fraction * float64(len(mp))))
+	if n < 1 {
+		n = 1
+	}
+
+	center := Center(mp)
+	dists := make([]float64, len(mp))
+	indexes := make([]int, len(mp))
+	for i, p := range mp {
+		dx, dy := p[0]-center[0], p[1]-center[1]
+		dists[i] = dx*dx + dy*dy
+		indexes[i] = i
+	}
+
+	sort.Slice(indexes, func(i, j int) bool {
+		return dists[indexes[i]] < dists[indexes[j]]
+	})
+
+	p := mp[indexes[0]]
+	b := Bound{Min: p, Max: p}
+	for _, i := range indexes[1:n] {
+		b = b.Extend(mp[i])
+	}
+
+	return b
+}
+
+// ThinByDistance returns the points that are at least minDist from all the
+// previously kept points, e.g. to declutter markers. The points are considered
+// in order so earlier points have priority. A minDist of zero or less returns
+// a copy of all the points. Distances are computed in the 2d plane.
+//
+// This package can not use the quadtree, which imports it, so the kept points
+// are indexed in a grid with cells of minDist and only points in neighboring
+// cells are compared. Points too far from the origin, relative to minDist, to
+// have a grid cell are compared with all the kept points.
+func ThinByDistance(mp MultiPoint, minDist float64) MultiPoint {
+	if mp == nil {
+		return nil
+	}
+
+	if !(minDist > 0) {
+		return mp.Clone()
+	}
+
+	type cell struct{ x, y int64 }
+	grid := make(map[cell][]Point)
+	limit := minDist * minDist
+
+	near := func(p Point, points []Point) bool {
+		for _, q := range points {
+			dx, dy := p[0]-q[0], p[1]-q[1]
+			if dx*dx+dy*dy < limit {
+				return true
+			}
+		}
+		return false
+	}
+
+	// maxCell keeps the cell indexes, and their neighbors, within int64
+	const maxCell = 1 << 62
+
+	var outside []Point // kept points without a grid cell
+	result := make(MultiPoint, 0, len(mp))
+	for _, p := range mp {
+		x, y := math.Floor(p[0]/minDist), math.Floor(p[1]/minDist)
+		if !(math.Abs(x) < maxCell && math.Abs(y) < maxCell) {
+			if !near(p, result) {
+				outside = append(outside, p)
+				result = append(result, p)
+			}
+			continue
+		}
+
+		// points closer than minDist can only be in the neighboring cells
+		c := cell{x: int64(x), y: int64(y)}
+		keep := !near(p, outside)
+		for x := c.x - 1; x <= c.x+1 && keep; x++ {
+			for y := c.y - 1; y <= c.y+1 && keep; y++ {
+				keep = !near(p, grid[cell{x, y}])
+			}
+		}
+
+		if keep {
+			grid[c] = append(grid[c], p)
+			result = append(result, p)
+		}
+	}
+
+	return result
+}
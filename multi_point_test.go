@@ -1,6 +1,8 @@
 package orb
 
 import (
+	"math"
+	"math/rand"
 	"testing"
 )
 
@@ -51,3 +53,150 @@ func TestMultiPointClone(t *testing.T) {
 		t.Error("clone should be equal")
 	}
 }
+
+func TestMultiPointMap(t *testing.T) {
+	mp := MultiPoint{{1, 2}, {3, 4}}
+	result := mp.Map(func(p Point) Point {
+		return Point{p[0] * 2, p[1] + 1}
+	})
+
+	if expected := (MultiPoint{{2, 3}, {6, 5}}); !result.Equal(expected) {
+		t.Errorf("incorrect result: %v != %v", result, expected)
+	}
+
+	if !mp.Equal(MultiPoint{{1, 2}, {3, 4}}) {
+		t.Errorf("should not modify original: %v", mp)
+	}
+
+	if v := MultiPoint(nil).Map(func(p Point) Point { return p }); v != nil {
+		t.Errorf("nil should map to nil: %v", v)
+	}
+}
+
+func TestSamplePoints(t *testing.T) {
+	mp := make(MultiPoint, 100)
+	for i := range mp {
+		mp[i] = Point{float64(i), 0}
+	}
+
+	r := rand.New(rand.NewSource(42))
+	sample := SamplePoints(mp, 10, r)
+	if len(sample) != 10 {
+		t.Fatalf("incorrect sample size: %v", len(sample))
+	}
+
+	seen := make(map[Point]bool)
+	for _, p := range sample {
+		if seen[p] {
+			t.Errorf("point sampled twice: %v", p)
+		}
+		seen[p] = true
+	}
+
+	// every point should be sampled at about the same rate
+	counts := make([]int, len(mp))
+	for i := 0; i < 10000; i++ {
+		for _, p := range SamplePoints(mp, 10, r) {
+			counts[int(p[0])]++
+		}
+	}
+
+	for i, c := range counts {
+		if c < 800 || c > 1200 {
+			t.Errorf("point %d sampled %d times, expected about 1000", i, c)
+		}
+	}
+
+	if v := SamplePoints(mp[:5], 10, nil); !v.Equal(mp[:5]) {
+		t.Errorf("should return all points: %v", v)
+	}
+
+	if v := SamplePoints(mp, 0, r); v != nil {
+		t.Errorf("should return nil for zero: %v", v)
+	}
+}
+
+func TestRobustBound(t *testing.T) {
+	mp := MultiPoint{}
+	for i := 0; i < 19; i++ {
+		mp = append(mp, Point{float64(i % 5), float64(i / 5)})
+	}
+	mp = append(mp, Point{1000, -1000})
+
+	b := RobustBound(mp, 0.95)
+	if expected := (Bound{Min: Point{0, 0}, Max: Point{4, 3}}); !b.Equal(expected) {
+		t.Errorf("incorrect bound: %v != %v", b, expected)
+	}
+
+	if b := RobustBound(mp, 1); !b.Equal(mp.Bound()) {
+		t.Errorf("should be the full bound: %v", b)
+	}
+
+	if b := RobustBound(mp, 0); b.Min != b.Max {
+		t.Errorf("should include a single point: %v", b)
+	}
+
+	if b := RobustBound(nil, 0.5); !b.IsEmpty() {
+		t.Errorf("should be empty: %v", b)
+	}
+}
+
+func TestThinByDistance(t *testing.T) {
+	mp := MultiPoint{{0, 0}, {0.5, 0}, {1, 0}, {1.5, 0.5}, {-0.9, -0.5}, {0, 0}}
+
+	result := ThinByDistance(mp, 1)
+	expected := MultiPoint{{0, 0}, {1, 0}, {-0.9, -0.5}}
+	if !result.Equal(expected) {
+		t.Errorf("incorrect result: %v", result)
+	}
+
+	if v := ThinByDistance(mp, 0); !v.Equal(mp) {
+		t.Errorf("zero distance should keep all the points: %v", v)
+	}
+
+	if v := ThinByDistance(nil, 1); v != nil {
+		t.Errorf("nil should return nil: %v", v)
+	}
+
+	// compare with brute force
+	r := rand.New(rand.NewSource(42))
+	mp = nil
+	for i := 0; i < 1000; i++ {
+		mp = append(mp, Point{20*r.Float64() - 10, 20*r.Float64() - 10})
+	}
+
+	var brute MultiPoint
+	for _, p := range mp {
+		keep := true
+		for _, q := range brute {
+			dx, dy := p[0]-q[0], p[1]-q[1]
+			if dx*dx+dy*dy < 0.7*0.7 {
+				keep = false
+				break
+			}
+		}
+
+		if keep {
+			brute = append(brute, p)
+		}
+	}
+
+	if result := ThinByDistance(mp, 0.7); !result.Equal(brute) {
+		t.Errorf("incorrect result: %d != %d points", len(result), len(brute))
+	}
+}
+
+func TestThinByDistance_largeCoordinates(t *testing.T) {
+	// the grid cell indexes would overflow int64
+	mp := MultiPoint{
+		{1e9, 0}, {1e9, 1e-11}, {-1e9, 0},
+		{0, 0}, {1e-11, 0}, {0, 1e-9},
+		{math.Inf(1), 0},
+	}
+
+	result := ThinByDistance(mp, 1e-10)
+	expected := MultiPoint{{1e9, 0}, {-1e9, 0}, {0, 0}, {0, 1e-9}, {math.Inf(1), 0}}
+	if !result.Equal(expected) {
+		t.Errorf("incorrect result: %v", result)
+	}
+}
@@ -26,6 +26,15 @@ func (mp MultiPolygon) Bound() Bound {
 	return bound
 }
 
+// Orient changes the winding of the rings of every polygon so the outer
+// rings have the given orientation and the holes the opposite.
+// This is done inplace, ie. it modifies the original data.
+func (mp MultiPolygon) Orient(o Orientation) {
+	for _, p := range mp {
+		p.Orient(o)
+	}
+}
+
 // Equal compares two multi-polygons.
 func (mp MultiPolygon) Equal(multiPolygon MultiPolygon) bool {
 	if len(mp) != len(multiPolygon) {
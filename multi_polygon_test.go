@@ -107,3 +107,17 @@ func TestMultiPolygon_Clone(t *testing.T) {
 		})
 	}
 }
+
+func TestMultiPolygon_Orient(t *testing.T) {
+	mp := MultiPolygon{
+		{{{0, 0}, {0, 1}, {1, 1}, {1, 0}, {0, 0}}},
+		{{{2, 0}, {3, 0}, {3, 1}, {2, 1}, {2, 0}}},
+	}
+
+	mp.Orient(CCW)
+	for i, p := range mp {
+		if o := p[0].Orientation(); o != CCW {
+			t.Errorf("incorrect orientation %d: %v", i, o)
+		}
+	}
+}
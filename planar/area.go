@@ -10,10 +10,90 @@ import (
 )
 
 // Area returns the area of the geometry in the 2d plane.
+// Points and lines have zero area. Polygon area has the holes subtracted
+// and will always be >= zero. Ring area may be negative if it has a clockwise
+// winding order. Multi-polygons and collections return the sum of their parts.
 func Area(g orb.Geometry) float64 {
-	// TODO: make faster non-centroid version.
-	_, a := CentroidArea(g)
-	return a
+	if g == nil {
+		return 0
+	}
+
+	switch g := g.(type) {
+	case orb.Point, orb.MultiPoint, orb.LineString, orb.MultiLineString:
+		return 0
+	case orb.Ring:
+		return ringArea(g)
+	case orb.Polygon:
+		return polygonArea(g)
+	case orb.MultiPolygon:
+		area := 0.0
+		for _, p := range g {
+			area += polygonArea(p)
+		}
+		return area
+	case orb.Collection:
+		area := 0.0
+		for _, c := range g {
+			area += Area(c)
+		}
+		return area
+	case orb.Bound:
+		return (g.Max[0] - g.Min[0]) * (g.Max[1] - g.Min[1])
+	}
+
+	panic(fmt.Sprintf("geometry type not supported: %T", g))
+}
+
+// IsCCW returns true if the points wind counter-clockwise, ie. have a positive
+// signed area. The points do not need to be closed. Less than 3 points,
+// or collinear points, are not counter-clockwise.
+func IsCCW(pts []orb.Point) bool {
+	return ringArea(pts) > 0
+}
+
+func ringArea(r orb.Ring) float64 {
+	if len(r) == 0 {
+		return 0
+	}
+
+	area := 0.0
+
+	// implicitly move everything to near the origin to help with roundoff
+	offsetX := r[0][0]
+	offsetY := r[0][1]
+	for i := 1; i < len(r)-1; i++ {
+		area += (r[i][0]-offsetX)*(r[i+1][1]-offsetY) -
+			(r[i+1][0]-offsetX)*(r[i][1]-offsetY)
+	}
+
+	return area / 2
+}
+
+func polygonArea(p orb.Polygon) float64 {
+	if len(p) == 0 {
+		return 0
+	}
+
+	area := math.Abs(ringArea(p[0]))
+	for i := 1; i < len(p); i++ {
+		area -= math.Abs(ringArea(p[i]))
+	}
+
+	return area
+}
+
+// Compactness returns the Polsby-Popper score of the polygon,
+// 4*pi*area / perimeter^2. A circle has a score of 1 and elongated
+// shapes have lower scores. The perimeter includes the holes.
+// Polygons with zero area return 0.
+func Compactness(p orb.Polygon) float64 {
+	a := Area(p)
+	if a == 0 {
+		return 0
+	}
+
+	l := Length(p)
+	return 4 * math.Pi * a / (l * l)
 }
 
 // CentroidArea returns both the centroid and the area in the 2d plane.
@@ -244,6 +324,29 @@ func multiPolygonCentroidArea(mp orb.MultiPolygon) (orb.Point, float64) {
 	return point, area
 }
 
+// MultiPolygonCentroid returns the area-weighted centroid of all the polygons,
+// with holes subtracted. This is useful as a single representative point for
+// a multi-part feature. If the total area is zero the average of the vertices
+// is returned, ignoring the closing point of each ring.
+func MultiPolygonCentroid(mp orb.MultiPolygon) orb.Point {
+	c, area := multiPolygonCentroidArea(mp)
+	if area != 0 {
+		return c
+	}
+
+	var points orb.MultiPoint
+	for _, p := range mp {
+		for _, r := range p {
+			if len(r) > 1 && r[0] == r[len(r)-1] {
+				r = r[:len(r)-1]
+			}
+			points = append(points, r...)
+		}
+	}
+
+	return multiPointCentroid(points)
+}
+
 func collectionCentroidArea(c orb.Collection) (orb.Point, float64) {
 	point := orb.Point{}
 	area := 0.0
@@ -1,6 +1,7 @@
 package planar
 
 import (
+	"math"
 	"testing"
 
 	"github.com/paulmach/orb"
@@ -12,6 +13,48 @@ func TestCentroidArea(t *testing.T) {
 	}
 }
 
+func TestArea(t *testing.T) {
+	for _, g := range orb.AllGeometries {
+		Area(g)
+	}
+
+	square := orb.Polygon{{{0, 0}, {4, 0}, {4, 4}, {0, 4}, {0, 0}}}
+	withHole := orb.Polygon{
+		{{0, 0}, {4, 0}, {4, 4}, {0, 4}, {0, 0}},
+		{{1, 1}, {1, 2}, {2, 2}, {2, 1}, {1, 1}},
+	}
+
+	cases := []struct {
+		name   string
+		geom   orb.Geometry
+		result float64
+	}{
+		{name: "point", geom: orb.Point{1, 1}, result: 0},
+		{name: "line string", geom: orb.LineString{{0, 0}, {1, 1}}, result: 0},
+		{name: "ring", geom: square[0], result: 16},
+		{name: "clockwise ring", geom: orb.Ring{{0, 0}, {0, 1}, {1, 1}, {1, 0}, {0, 0}}, result: -1},
+		{name: "polygon", geom: square, result: 16},
+		{name: "polygon with hole", geom: withHole, result: 15},
+		{name: "multi polygon", geom: orb.MultiPolygon{square, withHole}, result: 31},
+		{name: "collection", geom: orb.Collection{orb.Point{1, 1}, square, withHole}, result: 31},
+		{name: "bound", geom: orb.Bound{Min: orb.Point{0, 2}, Max: orb.Point{1, 5}}, result: 3},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			if a := Area(tc.geom); a != tc.result {
+				t.Errorf("incorrect area: %v != %v", a, tc.result)
+			}
+
+			if tc.result >= 0 {
+				if _, a := CentroidArea(tc.geom); a != tc.result {
+					t.Errorf("should match CentroidArea: %v != %v", a, tc.result)
+				}
+			}
+		})
+	}
+}
+
 func TestCentroidArea_MultiPoint(t *testing.T) {
 	mp := orb.MultiPoint{{0, 0}, {1, 1.5}, {2, 0}}
 
@@ -305,3 +348,116 @@ func TestCentroidArea_Bound(t *testing.T) {
 		t.Errorf("area should be zero: %f", area)
 	}
 }
+
+func TestMultiPolygonCentroid(t *testing.T) {
+	mp := orb.MultiPolygon{
+		{{{0, 0}, {2, 0}, {2, 2}, {0, 2}, {0, 0}}},
+		{{{10, 0}, {11, 0}, {11, 1}, {10, 1}, {10, 0}}},
+	}
+
+	expected := orb.Point{2.9, 0.9}
+	if c := MultiPolygonCentroid(mp); Distance(c, expected) > 1e-10 {
+		t.Errorf("incorrect centroid: %v != %v", c, expected)
+	}
+
+	// the hole is subtracted
+	mp = orb.MultiPolygon{
+		{
+			{{0, 0}, {4, 0}, {4, 4}, {0, 4}, {0, 0}},
+			{{0, 0}, {0, 2}, {2, 2}, {2, 0}, {0, 0}},
+		},
+	}
+
+	expected = orb.Point{7.0 / 3.0, 7.0 / 3.0}
+	if c := MultiPolygonCentroid(mp); Distance(c, expected) > 1e-10 {
+		t.Errorf("incorrect centroid: %v != %v", c, expected)
+	}
+
+	// zero area uses the vertex average
+	mp = orb.MultiPolygon{
+		{{{0, 0}, {2, 0}, {0, 0}}},
+		{{{4, 3}, {4, 3}}},
+	}
+
+	expected = orb.Point{2, 1}
+	if c := MultiPolygonCentroid(mp); !c.Equal(expected) {
+		t.Errorf("incorrect centroid: %v != %v", c, expected)
+	}
+
+	if c := MultiPolygonCentroid(nil); !c.Equal(orb.Point{}) {
+		t.Errorf("empty should be zero point: %v", c)
+	}
+}
+
+func TestCompactness(t *testing.T) {
+	square := orb.Polygon{{{0, 0}, {1, 0}, {1, 1}, {0, 1}, {0, 0}}}
+	if v := Compactness(square); math.Abs(v-math.Pi/4) > 1e-10 {
+		t.Errorf("incorrect square compactness: %v != %v", v, math.Pi/4)
+	}
+
+	circle := orb.Ring{}
+	for i := 0; i <= 360; i++ {
+		a := float64(i) * math.Pi / 180
+		circle = append(circle, orb.Point{math.Cos(a), math.Sin(a)})
+	}
+
+	if v := Compactness(orb.Polygon{circle}); math.Abs(v-1) > 1e-4 {
+		t.Errorf("circle should have compactness close to 1: %v", v)
+	}
+
+	long := orb.Polygon{{{0, 0}, {100, 0}, {100, 1}, {0, 1}, {0, 0}}}
+	if v := Compactness(long); v >= Compactness(square) {
+		t.Errorf("elongated shape should be less compact: %v", v)
+	}
+
+	line := orb.Polygon{{{0, 0}, {1, 0}, {0, 0}}}
+	if v := Compactness(line); v != 0 {
+		t.Errorf("zero area should return 0: %v", v)
+	}
+
+	if v := Compactness(nil); v != 0 {
+		t.Errorf("nil should return 0: %v", v)
+	}
+}
+
+func TestIsCCW(t *testing.T) {
+	cases := []struct {
+		name   string
+		points []orb.Point
+		ccw    bool
+	}{
+		{
+			name:   "ccw closed",
+			points: []orb.Point{{0, 0}, {1, 0}, {1, 1}, {0, 1}, {0, 0}},
+			ccw:    true,
+		},
+		{
+			name:   "ccw open",
+			points: []orb.Point{{0, 0}, {1, 0}, {1, 1}, {0, 1}},
+			ccw:    true,
+		},
+		{
+			name:   "cw",
+			points: []orb.Point{{0, 0}, {0, 1}, {1, 1}, {1, 0}},
+			ccw:    false,
+		},
+		{
+			name:   "collinear",
+			points: []orb.Point{{0, 0}, {1, 1}, {2, 2}},
+			ccw:    false,
+		},
+		{
+			name:   "empty",
+			points: nil,
+			ccw:    false,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			if v := IsCCW(tc.points); v != tc.ccw {
+				t.Errorf("incorrect result: %v != %v", v, tc.ccw)
+			}
+		})
+	}
+}
@@ -0,0 +1,394 @@
+package planar
+
+import (
+	"math"
+	"sort"
+
+	"github.com/paulmach/orb"
+)
+
+// BufferUnion buffers each point by the given distance and merges the buffers
+// that overlap. Every buffer is a regular polygon with the given number of
+// segments, at least 3, approximating the circle around the point. Buffers
+// that do not overlap other buffers are returned as separate polygons. Merged
+// buffers that enclose an area not covered by any buffer will have a hole.
+// The polygons are ordered by the first point in each group.
+func BufferUnion(mp orb.MultiPoint, dist float64, segments int) orb.MultiPolygon {
+	if dist <= 0 || len(mp) == 0 {
+		return nil
+	}
+
+	if segments < 3 {
+		segments = 3
+	}
+
+	points := uniquePoints(mp)
+	rings := make([]orb.Ring, len(points))
+	bounds := make([]orb.Bound, len(points))
+	for i, p := range points {
+		rings[i] = circleRing(p, dist, segments)
+		bounds[i] = rings[i].Bound()
+	}
+
+	// group the buffers with overlapping interiors
+	parent := make([]int, len(rings))
+	for i := range parent {
+		parent[i] = i
+	}
+
+	var find func(int) int
+	find = func(i int) int {
+		if parent[i] != i {
+			parent[i] = find(parent[i])
+		}
+		return parent[i]
+	}
+
+	for i := range rings {
+		for j := i + 1; j < len(rings); j++ {
+			if !bounds[i].Intersects(bounds[j]) || find(i) == find(j) {
+				continue
+			}
+
+			a, b := orb.Polygon{rings[i]}, orb.Polygon{rings[j]}
+			if edgesCross(a, b) || interiorPointIn(a, b) || interiorPointIn(b, a) {
+				parent[find(j)] = find(i)
+			}
+		}
+	}
+
+	var groups [][]int
+	index := make(map[int]int)
+	for i := range rings {
+		root := find(i)
+		g, ok := index[root]
+		if !ok {
+			g = len(groups)
+			index[root] = g
+			groups = append(groups, nil)
+		}
+		groups[g] = append(groups[g], i)
+	}
+
+	var result orb.MultiPolygon
+	for _, g := range groups {
+		if len(g) == 1 {
+			result = append(result, orb.Polygon{rings[g[0]]})
+			continue
+		}
+
+		rs := make([]orb.Ring, len(g))
+		bs := make([]orb.Bound, len(g))
+		for i, k := range g {
+			rs[i] = rings[k]
+			bs[i] = bounds[k]
+		}
+
+		result = append(result, unionRings(rs, bs)...)
+	}
+
+	return result
+}
+
+// circleRing returns a closed counter-clockwise regular polygon
+// with the given number of segments around the center.
+func circleRing(center orb.Point, radius float64, segments int) orb.Ring {
+	r := make(orb.Ring, 0, segments+1)
+	for i := 0; i < segments; i++ {
+		sin, cos := circleVertex(i, segments)
+		r = append(r, orb.Point{
+			center[0] + radius*cos,
+			center[1] + radius*sin,
+		})
+	}
+
+	return append(r, r[0])
+}
+
+// circleVertex returns the sine and cosine of the angle of the i-th of n
+// vertices around the unit circle. The angles are folded into the first
+// quadrant so mirrored vertices have exactly mirrored values, and the quarter
+// turns are exact, so edges of buffers that are aligned with each other
+// are exactly collinear.
+func circleVertex(i, n int) (float64, float64) {
+	var sin, cos float64
+	switch {
+	case 4*i <= n:
+		sin, cos = math.Sincos(math.Pi * float64(2*i) / float64(n))
+	case 4*i <= 2*n:
+		sin, cos = math.Sincos(math.Pi * float64(n-2*i) / float64(n))
+		cos = -cos
+	case 4*i <= 3*n:
+		sin, cos = math.Sincos(math.Pi * float64(2*i-n) / float64(n))
+		sin, cos = -sin, -cos
+	default:
+		sin, cos = math.Sincos(math.Pi * float64(2*n-2*i) / float64(n))
+		sin = -sin
+	}
+
+	if math.Abs(sin) < 1e-15 {
+		sin = 0
+	}
+
+	if math.Abs(cos) < 1e-15 {
+		cos = 0
+	}
+
+	return sin, cos
+}
+
+type edgeSplit struct {
+	t     float64
+	point orb.Point
+}
+
+// unionRings returns the union of the closed counter-clockwise rings.
+// The edges are split where they intersect, the pieces inside the other
+// rings are removed and the rest are chained into the new rings.
+func unionRings(rings []orb.Ring, bounds []orb.Bound) orb.MultiPolygon {
+	splits := make([][][]edgeSplit, len(rings))
+	for i, r := range rings {
+		splits[i] = make([][]edgeSplit, len(r)-1)
+	}
+
+	// intersections of more than two edges are computed separately for each
+	// pair, snapping makes sure they end up at the same point.
+	total := bounds[0]
+	for _, b := range bounds[1:] {
+		total = total.Union(b)
+	}
+
+	snap := newSnapper(1e-9 * math.Max(total.Max[0]-total.Min[0], total.Max[1]-total.Min[1]))
+	for _, r := range rings {
+		for _, p := range r {
+			snap.Point(p)
+		}
+	}
+
+	for i, a := range rings {
+		for j := i + 1; j < len(rings); j++ {
+			if !bounds[i].Intersects(bounds[j]) {
+				continue
+			}
+
+			b := rings[j]
+			for k := 0; k < len(a)-1; k++ {
+				for l := 0; l < len(b)-1; l++ {
+					splitEdges(a[k], a[k+1], b[l], b[l+1], snap, &splits[i][k], &splits[j][l])
+				}
+			}
+		}
+	}
+
+	// the pieces of the edges that are on the boundary of the union
+	type edge struct{ start, end orb.Point }
+	var edges []edge
+	for i, r := range rings {
+		for k := 0; k < len(r)-1; k++ {
+			s := splits[i][k]
+			sort.Slice(s, func(x, y int) bool { return s[x].t < s[y].t })
+
+			prev := r[k]
+			for _, sp := range append(s, edgeSplit{t: 1, point: r[k+1]}) {
+				if sp.point == prev {
+					continue
+				}
+
+				if onUnionBoundary(rings, bounds, i, prev, sp.point) {
+					edges = append(edges, edge{start: prev, end: sp.point})
+				}
+				prev = sp.point
+			}
+		}
+	}
+
+	outgoing := make(map[orb.Point][]int, len(edges))
+	for i, e := range edges {
+		outgoing[e.start] = append(outgoing[e.start], i)
+	}
+
+	used := make([]bool, len(edges))
+	var outers, holes []orb.Ring
+	for i := range edges {
+		if used[i] {
+			continue
+		}
+
+		used[i] = true
+		ring := orb.Ring{edges[i].start, edges[i].end}
+		current := i
+		for ring[len(ring)-1] != ring[0] {
+			e := edges[current]
+			candidates := outgoing[e.end]
+			ends := make([]orb.Point, len(candidates))
+			for k, c := range candidates {
+				if used[c] {
+					ends[k] = e.end
+				} else {
+					ends[k] = edges[c].end
+				}
+			}
+
+			k := leftmostTurn(e.start, e.end, ends)
+			if k == -1 {
+				break
+			}
+
+			next := candidates[k]
+			used[next] = true
+			ring = append(ring, edges[next].end)
+			current = next
+		}
+
+		if ring[len(ring)-1] != ring[0] || len(ring) < 4 {
+			continue
+		}
+
+		if a := ringArea(ring); a > 0 {
+			outers = append(outers, ring)
+		} else if a < 0 {
+			holes = append(holes, ring)
+		}
+	}
+
+	result := make(orb.MultiPolygon, len(outers))
+	for i, r := range outers {
+		result[i] = orb.Polygon{r}
+	}
+
+	for _, h := range holes {
+		for i, r := range outers {
+			if RingContains(r, h[0]) {
+				result[i] = append(result[i], h)
+				break
+			}
+		}
+	}
+
+	return result
+}
+
+// splitEdges records where the segments a1-a2 and b1-b2 intersect. The same
+// snapped point is used for both segments so the pieces connect exactly.
+func splitEdges(a1, a2, b1, b2 orb.Point, snap *snapper, sa, sb *[]edgeSplit) {
+	if math.Max(a1[0], a2[0]) < math.Min(b1[0], b2[0]) ||
+		math.Min(a1[0], a2[0]) > math.Max(b1[0], b2[0]) ||
+		math.Max(a1[1], a2[1]) < math.Min(b1[1], b2[1]) ||
+		math.Min(a1[1], a2[1]) > math.Max(b1[1], b2[1]) {
+		return
+	}
+
+	bx, by := b2[0]-b1[0], b2[1]-b1[1]
+	for _, t := range segmentIntersections(a1, a2, b1, b2) {
+		p := snap.Point(orb.Point{a1[0] + t*(a2[0]-a1[0]), a1[1] + t*(a2[1]-a1[1])})
+
+		if p != a1 && p != a2 {
+			*sa = append(*sa, edgeSplit{t: t, point: p})
+		}
+
+		if p != b1 && p != b2 {
+			u := ((p[0]-b1[0])*bx + (p[1]-b1[1])*by) / (bx*bx + by*by)
+			*sb = append(*sb, edgeSplit{t: u, point: p})
+		}
+	}
+}
+
+// snapper returns the same point for all points within the tolerance
+// of each other, the first point seen is used.
+type snapper struct {
+	tolerance float64
+	cells     map[[2]int64][]orb.Point
+}
+
+func newSnapper(tolerance float64) *snapper {
+	return &snapper{
+		tolerance: tolerance,
+		cells:     make(map[[2]int64][]orb.Point),
+	}
+}
+
+func (s *snapper) Point(p orb.Point) orb.Point {
+	if s.tolerance == 0 {
+		return p
+	}
+
+	x := int64(math.Floor(p[0] / s.tolerance))
+	y := int64(math.Floor(p[1] / s.tolerance))
+	for i := x - 1; i <= x+1; i++ {
+		for j := y - 1; j <= y+1; j++ {
+			for _, q := range s.cells[[2]int64{i, j}] {
+				if math.Abs(p[0]-q[0]) <= s.tolerance && math.Abs(p[1]-q[1]) <= s.tolerance {
+					return q
+				}
+			}
+		}
+	}
+
+	key := [2]int64{x, y}
+	s.cells[key] = append(s.cells[key], p)
+	return p
+}
+
+// onUnionBoundary returns true if the piece of an edge of ring i is part of
+// the boundary of the union, ie. it is not inside any of the other rings.
+// Pieces shared by two rings in the same direction are kept only once,
+// pieces shared in opposite directions are interior to the union.
+func onUnionBoundary(rings []orb.Ring, bounds []orb.Bound, i int, start, end orb.Point) bool {
+	mid := orb.Point{(start[0] + end[0]) / 2, (start[1] + end[1]) / 2}
+	dx, dy := end[0]-start[0], end[1]-start[1]
+
+	for j, r := range rings {
+		if j == i || !bounds[j].Contains(mid) {
+			continue
+		}
+
+		if on, same := boundaryDirection(r, mid, dx, dy); on {
+			if !same || j < i {
+				return false
+			}
+			continue
+		}
+
+		if RingContains(r, mid) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// boundaryDirection returns if the point is on the boundary of the ring and
+// if so, if that part of the boundary goes in the same direction as dx, dy.
+func boundaryDirection(r orb.Ring, p orb.Point, dx, dy float64) (bool, bool) {
+	for k := 0; k < len(r)-1; k++ {
+		if _, on := rayIntersect(p, r[k], r[k+1]); on {
+			return true, dx*(r[k+1][0]-r[k][0])+dy*(r[k+1][1]-r[k][1]) > 0
+		}
+	}
+
+	return false, false
+}
+
+// leftmostTurn returns the index of the point that turns the most to the
+// left coming from the segment a-b. Points equal to b are ignored.
+// This keeps rings that touch at a vertex separate.
+func leftmostTurn(a, b orb.Point, points []orb.Point) int {
+	best := -1
+	bestAngle := 0.0
+
+	dx, dy := b[0]-a[0], b[1]-a[1]
+	for i, c := range points {
+		if c == b {
+			continue
+		}
+
+		ex, ey := c[0]-b[0], c[1]-b[1]
+		angle := math.Atan2(dx*ey-dy*ex, dx*ex+dy*ey)
+		if best == -1 || angle > bestAngle {
+			best = i
+			bestAngle = angle
+		}
+	}
+
+	return best
+}
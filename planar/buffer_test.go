@@ -0,0 +1,106 @@
+package planar
+
+import (
+	"math"
+	"testing"
+
+	"github.com/paulmach/orb"
+)
+
+func TestBufferUnion(t *testing.T) {
+	circleArea := Area(circleRing(orb.Point{0, 0}, 1, 64))
+
+	t.Run("separate", func(t *testing.T) {
+		mp := BufferUnion(orb.MultiPoint{{0, 0}, {5, 0}, {0, 0}}, 1, 64)
+		if len(mp) != 2 {
+			t.Fatalf("should have 2 polygons: %v", len(mp))
+		}
+
+		for _, p := range mp {
+			if len(p) != 1 || len(p[0]) != 65 {
+				t.Errorf("should be a single circle: %v", p)
+			}
+		}
+	})
+
+	t.Run("overlapping", func(t *testing.T) {
+		mp := BufferUnion(orb.MultiPoint{{0, 0}, {1, 0}, {10, 10}}, 1, 64)
+		if len(mp) != 2 {
+			t.Fatalf("should have 2 polygons: %v", len(mp))
+		}
+
+		if len(mp[0]) != 1 || !mp[0][0].Closed() {
+			t.Fatalf("should be one closed ring: %v", mp[0])
+		}
+
+		// the lens where the unit circles overlap, the polygons are a bit smaller
+		lens := 2*math.Acos(0.5) - 0.5*math.Sqrt(3)
+		expected := 2*math.Pi - lens
+		if a := Area(mp[0]); math.Abs(a-expected)/expected > 0.01 {
+			t.Errorf("incorrect area: %v != %v", a, expected)
+		}
+
+		if a := Area(mp[0]); a <= circleArea || a >= 2*circleArea {
+			t.Errorf("area should be between one and two circles: %v", a)
+		}
+
+		// the vertices are on the boundary of one of the circles
+		c1 := orb.Polygon{circleRing(orb.Point{0, 0}, 1, 64)}
+		c2 := orb.Polygon{circleRing(orb.Point{1, 0}, 1, 64)}
+		for _, p := range mp[0][0] {
+			if DistanceFrom(c1, p) > 1e-10 && DistanceFrom(c2, p) > 1e-10 {
+				t.Errorf("point not on either circle: %v", p)
+			}
+		}
+	})
+
+	t.Run("with a hole", func(t *testing.T) {
+		var points orb.MultiPoint
+		for i := 0; i < 12; i++ {
+			a := 2 * math.Pi * float64(i) / 12
+			points = append(points, orb.Point{5 * math.Cos(a), 5 * math.Sin(a)})
+		}
+
+		mp := BufferUnion(points, 1.5, 32)
+		if len(mp) != 1 {
+			t.Fatalf("should have 1 polygon: %v", len(mp))
+		}
+
+		if len(mp[0]) != 2 {
+			t.Fatalf("should have a hole: %v", len(mp[0]))
+		}
+
+		if mp[0][0].Orientation() != orb.CCW || mp[0][1].Orientation() != orb.CW {
+			t.Errorf("incorrect orientations")
+		}
+
+		if !PolygonContains(mp[0], orb.Point{5, 0}) || PolygonContains(mp[0], orb.Point{0, 0}) {
+			t.Errorf("hole should be in the center")
+		}
+	})
+
+	t.Run("shared edges", func(t *testing.T) {
+		// squares shifted along an edge share part of it
+		rings := []orb.Ring{
+			{{0, 0}, {2, 0}, {2, 2}, {0, 2}, {0, 0}},
+			{{1, 0}, {3, 0}, {3, 2}, {1, 2}, {1, 0}},
+		}
+
+		mp := unionRings(rings, []orb.Bound{rings[0].Bound(), rings[1].Bound()})
+		if len(mp) != 1 || len(mp[0]) != 1 {
+			t.Fatalf("should be one polygon without holes: %v", mp)
+		}
+
+		if a := Area(mp[0]); a != 6 {
+			t.Errorf("incorrect area: %v != 6", a)
+		}
+	})
+
+	if v := BufferUnion(nil, 1, 16); v != nil {
+		t.Errorf("should be nil for no points: %v", v)
+	}
+
+	if v := BufferUnion(orb.MultiPoint{{0, 0}}, 0, 16); v != nil {
+		t.Errorf("should be nil for zero distance: %v", v)
+	}
+}
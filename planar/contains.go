@@ -7,12 +7,51 @@ import (
 )
 
 // RingContains returns true if the point is inside the ring.
-// Points on the boundary are considered in.
+// Points on the boundary are considered in. Rings that are axis-aligned
+// rectangles only require a bound check.
 func RingContains(r orb.Ring, point orb.Point) bool {
 	if !r.Bound().Contains(point) {
 		return false
 	}
 
+	if isRectangle(r) {
+		return true
+	}
+
+	return rayCastContains(r, point)
+}
+
+// isRectangle returns true if the ring is an axis-aligned rectangle,
+// ie. it has 4 edges that are alternately horizontal and vertical.
+// The closing point is optional.
+func isRectangle(r orb.Ring) bool {
+	n := len(r)
+	if n == 5 && r[0] == r[4] {
+		n = 4
+	}
+
+	if n != 4 {
+		return false
+	}
+
+	horizontal := r[0][1] == r[1][1]
+	for i := 0; i < 4; i++ {
+		a, b := r[i], r[(i+1)%4]
+		if horizontal {
+			if a[1] != b[1] || a[0] == b[0] {
+				return false
+			}
+		} else if a[0] != b[0] || a[1] == b[1] {
+			return false
+		}
+
+		horizontal = !horizontal
+	}
+
+	return true
+}
+
+func rayCastContains(r orb.Ring, point orb.Point) bool {
 	c, on := rayIntersect(point, r[0], r[len(r)-1])
 	if on {
 		return true
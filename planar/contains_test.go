@@ -117,6 +117,42 @@ func TestRingContains(t *testing.T) {
 	}
 }
 
+func TestRingContains_rectangle(t *testing.T) {
+	rings := []orb.Ring{
+		{{0, 0}, {2, 0}, {2, 1}, {0, 1}, {0, 0}},
+		{{0, 0}, {0, 1}, {2, 1}, {2, 0}, {0, 0}},
+		{{2, 1}, {0, 1}, {0, 0}, {2, 0}},
+	}
+
+	for _, r := range rings {
+		if !isRectangle(r) {
+			t.Errorf("should be a rectangle: %v", r)
+		}
+
+		for x := -0.5; x <= 2.5; x += 0.25 {
+			for y := -0.5; y <= 1.5; y += 0.25 {
+				p := orb.Point{x, y}
+				if v := RingContains(r, p); v != rayCastContains(r, p) {
+					t.Errorf("%v: should match ray casting for %v: %v", r, p, v)
+				}
+			}
+		}
+	}
+
+	others := []orb.Ring{
+		{{0, 0}, {2, 0}, {2, 1}, {0, 2}, {0, 0}},
+		{{0, 0}, {2, 0}, {2, 0}, {0, 0}, {0, 0}},
+		{{0, 0}, {1, 0}, {2, 0}, {2, 1}, {0, 1}, {0, 0}},
+		{{0, 0}, {2, 0}, {2, 1}, {0, 0}},
+	}
+
+	for _, r := range others {
+		if isRectangle(r) {
+			t.Errorf("should not be a rectangle: %v", r)
+		}
+	}
+}
+
 func TestPolygonContains(t *testing.T) {
 	// should exclude holes
 	p := orb.Polygon{
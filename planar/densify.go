@@ -0,0 +1,40 @@
+package planar
+
+import (
+	"math"
+
+	"github.com/paulmach/orb"
+)
+
+// DensifyMax returns a new line string where any segment longer than maxLen
+// is split into equal parts no longer than maxLen. The original points are kept.
+// A non-positive maxLen returns a copy of the line string.
+func DensifyMax(ls orb.LineString, maxLen float64) orb.LineString {
+	if ls == nil {
+		return nil
+	}
+
+	if maxLen <= 0 || len(ls) < 2 {
+		return ls.Clone()
+	}
+
+	result := make(orb.LineString, 0, len(ls))
+	result = append(result, ls[0])
+	for i := 0; i < len(ls)-1; i++ {
+		d := Distance(ls[i], ls[i+1])
+		if n := int(math.Ceil(d / maxLen)); n > 1 {
+			dx := (ls[i+1][0] - ls[i][0]) / float64(n)
+			dy := (ls[i+1][1] - ls[i][1]) / float64(n)
+			for j := 1; j < n; j++ {
+				result = append(result, orb.Point{
+					ls[i][0] + float64(j)*dx,
+					ls[i][1] + float64(j)*dy,
+				})
+			}
+		}
+
+		result = append(result, ls[i+1])
+	}
+
+	return result
+}
@@ -0,0 +1,56 @@
+package planar
+
+import (
+	"testing"
+
+	"github.com/paulmach/orb"
+)
+
+func TestDensifyMax(t *testing.T) {
+	cases := []struct {
+		name     string
+		ls       orb.LineString
+		maxLen   float64
+		expected orb.LineString
+	}{
+		{
+			name:     "split segment",
+			ls:       orb.LineString{{0, 0}, {10, 0}},
+			maxLen:   4,
+			expected: orb.LineString{{0, 0}, {10.0 / 3, 0}, {20.0 / 3, 0}, {10, 0}},
+		},
+		{
+			name:     "exact length",
+			ls:       orb.LineString{{0, 0}, {0, 4}, {0, 6}},
+			maxLen:   2,
+			expected: orb.LineString{{0, 0}, {0, 2}, {0, 4}, {0, 6}},
+		},
+		{
+			name:     "short segments",
+			ls:       orb.LineString{{0, 0}, {1, 1}, {2, 0}},
+			maxLen:   5,
+			expected: orb.LineString{{0, 0}, {1, 1}, {2, 0}},
+		},
+		{
+			name:     "non-positive max",
+			ls:       orb.LineString{{0, 0}, {10, 0}},
+			maxLen:   0,
+			expected: orb.LineString{{0, 0}, {10, 0}},
+		},
+		{
+			name:     "empty",
+			ls:       orb.LineString{},
+			maxLen:   1,
+			expected: orb.LineString{},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			v := DensifyMax(tc.ls, tc.maxLen)
+			if !v.Equal(tc.expected) {
+				t.Errorf("incorrect line: %v != %v", v, tc.expected)
+			}
+		})
+	}
+}
@@ -0,0 +1,54 @@
+package planar
+
+import (
+	"math"
+
+	"github.com/paulmach/orb"
+)
+
+// Diameter returns the two points in the set that are farthest apart
+// and the distance between them. The convex hull is computed first and the
+// pair found using rotating calipers, so this is O(n log n).
+// Sets with less than 2 points return zero values.
+func Diameter(mp orb.MultiPoint) (orb.Point, orb.Point, float64) {
+	if len(mp) < 2 {
+		return orb.Point{}, orb.Point{}, 0
+	}
+
+	hull := convexHull(uniquePoints(mp))
+	hull = hull[:len(hull)-1] // the closing point
+
+	n := len(hull)
+	if n == 1 {
+		return hull[0], hull[0], 0
+	}
+
+	if n == 2 {
+		return hull[0], hull[1], Distance(hull[0], hull[1])
+	}
+
+	var a, b orb.Point
+	max := -1.0
+
+	check := func(p1, p2 orb.Point) {
+		if d := DistanceSquared(p1, p2); d > max {
+			a, b, max = p1, p2, d
+		}
+	}
+
+	// for every edge advance j to the vertex farthest from it,
+	// the farthest pair is one of these antipodal pairs.
+	j := 1
+	for i := 0; i < n; i++ {
+		next := (i + 1) % n
+		for math.Abs(cross(hull[i], hull[next], hull[(j+1)%n])) >
+			math.Abs(cross(hull[i], hull[next], hull[j])) {
+			j = (j + 1) % n
+		}
+
+		check(hull[i], hull[j])
+		check(hull[next], hull[j])
+	}
+
+	return a, b, math.Sqrt(max)
+}
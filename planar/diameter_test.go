@@ -0,0 +1,77 @@
+package planar
+
+import (
+	"math"
+	"math/rand"
+	"testing"
+
+	"github.com/paulmach/orb"
+)
+
+func TestDiameter(t *testing.T) {
+	cases := []struct {
+		name     string
+		input    orb.MultiPoint
+		distance float64
+	}{
+		{
+			name:     "square",
+			input:    orb.MultiPoint{{0, 0}, {1, 0}, {1, 1}, {0, 1}, {0.5, 0.5}},
+			distance: math.Sqrt2,
+		},
+		{
+			name:     "collinear",
+			input:    orb.MultiPoint{{1, 1}, {0, 0}, {3, 3}, {2, 2}},
+			distance: 3 * math.Sqrt2,
+		},
+		{
+			name:     "two points",
+			input:    orb.MultiPoint{{0, 0}, {3, 4}},
+			distance: 5,
+		},
+		{
+			name:     "repeated point",
+			input:    orb.MultiPoint{{1, 2}, {1, 2}},
+			distance: 0,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			a, b, d := Diameter(tc.input)
+			if math.Abs(d-tc.distance) > 1e-10 {
+				t.Errorf("incorrect distance: %v != %v", d, tc.distance)
+			}
+
+			if v := Distance(a, b); math.Abs(v-d) > 1e-10 {
+				t.Errorf("distance does not match points: %v %v", a, b)
+			}
+		})
+	}
+
+	a, b, d := Diameter(orb.MultiPoint{{1, 2}})
+	if !a.Equal(orb.Point{}) || !b.Equal(orb.Point{}) || d != 0 {
+		t.Errorf("single point should return zero values: %v %v %v", a, b, d)
+	}
+}
+
+func TestDiameter_bruteForce(t *testing.T) {
+	r := rand.New(rand.NewSource(42))
+	for i := 0; i < 100; i++ {
+		mp := make(orb.MultiPoint, 2+r.Intn(50))
+		for j := range mp {
+			mp[j] = orb.Point{r.Float64() * 100, r.Float64() * 100}
+		}
+
+		max := 0.0
+		for j := range mp {
+			for k := j + 1; k < len(mp); k++ {
+				max = math.Max(max, Distance(mp[j], mp[k]))
+			}
+		}
+
+		if _, _, d := Diameter(mp); math.Abs(d-max) > 1e-10 {
+			t.Fatalf("incorrect distance: %v != %v", d, max)
+		}
+	}
+}
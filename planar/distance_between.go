@@ -0,0 +1,167 @@
+package planar
+
+import (
+	"math"
+
+	"github.com/paulmach/orb"
+)
+
+// DistanceBetween returns the minimum distance between any parts of the two
+// geometries. It is 0 if they intersect, including when one is inside the area
+// of a polygon of the other. Rings and bounds are considered areas like polygons.
+// Pairs of segments whose bounds are further apart than the current minimum are
+// skipped. If either geometry is nil or empty the result is +Inf.
+func DistanceBetween(a, b orb.Geometry) float64 {
+	if a == nil || b == nil {
+		return math.Inf(1)
+	}
+
+	pa, pb := &distanceParts{}, &distanceParts{}
+	pa.collect(a)
+	pb.collect(b)
+
+	if len(pa.points) == 0 || len(pb.points) == 0 {
+		return math.Inf(1)
+	}
+
+	if pa.areaContains(pb) || pb.areaContains(pa) {
+		return 0
+	}
+
+	best := math.Inf(1)
+	for _, sa := range pa.segments {
+		ba := segmentBound(sa)
+		for _, sb := range pb.segments {
+			if boundsDistanceSquared(ba, segmentBound(sb)) >= best {
+				continue
+			}
+
+			if segmentIntersections(sa[0], sa[1], sb[0], sb[1]) != nil {
+				return 0
+			}
+
+			best = math.Min(best, segmentDistanceFromSquared(sa[0], sa[1], sb[0]))
+			best = math.Min(best, segmentDistanceFromSquared(sa[0], sa[1], sb[1]))
+			best = math.Min(best, segmentDistanceFromSquared(sb[0], sb[1], sa[0]))
+			best = math.Min(best, segmentDistanceFromSquared(sb[0], sb[1], sa[1]))
+		}
+	}
+
+	// lone points are compared with everything in the other geometry
+	best = math.Min(best, pa.lonePointsDistanceSquared(pb))
+	best = math.Min(best, pb.lonePointsDistanceSquared(pa))
+
+	return math.Sqrt(best)
+}
+
+// distanceParts is a geometry split into the parts needed to compute distances.
+type distanceParts struct {
+	points   []orb.Point // all the vertices
+	lone     []orb.Point // points not part of a segment
+	segments [][2]orb.Point
+	areas    []orb.Polygon
+}
+
+func (d *distanceParts) collect(g orb.Geometry) {
+	switch g := g.(type) {
+	case orb.Point:
+		d.addPoint(g)
+	case orb.MultiPoint:
+		for _, p := range g {
+			d.addPoint(p)
+		}
+	case orb.LineString:
+		d.addPath(g)
+	case orb.MultiLineString:
+		for _, ls := range g {
+			d.addPath(ls)
+		}
+	case orb.Ring:
+		d.addPolygon(orb.Polygon{g})
+	case orb.Polygon:
+		d.addPolygon(g)
+	case orb.MultiPolygon:
+		for _, p := range g {
+			d.addPolygon(p)
+		}
+	case orb.Collection:
+		for _, c := range g {
+			d.collect(c)
+		}
+	case orb.Bound:
+		d.addPolygon(g.ToPolygon())
+	}
+}
+
+func (d *distanceParts) addPoint(p orb.Point) {
+	d.points = append(d.points, p)
+	d.lone = append(d.lone, p)
+}
+
+func (d *distanceParts) addPath(ps []orb.Point) {
+	if len(ps) == 1 {
+		d.addPoint(ps[0])
+		return
+	}
+
+	d.points = append(d.points, ps...)
+	for i := 0; i < len(ps)-1; i++ {
+		d.segments = append(d.segments, [2]orb.Point{ps[i], ps[i+1]})
+	}
+}
+
+func (d *distanceParts) addPolygon(p orb.Polygon) {
+	if len(p) == 0 || len(p[0]) == 0 {
+		return
+	}
+
+	for _, r := range p {
+		d.addPath(r)
+	}
+	d.areas = append(d.areas, p)
+}
+
+// areaContains returns true if a vertex of the other
+// geometry is inside one of the areas.
+func (d *distanceParts) areaContains(other *distanceParts) bool {
+	for _, a := range d.areas {
+		b := a.Bound()
+		for _, p := range other.points {
+			if b.Contains(p) && PolygonContains(a, p) {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// lonePointsDistanceSquared returns the minimum squared distance
+// from the lone points to any part of the other geometry.
+func (d *distanceParts) lonePointsDistanceSquared(other *distanceParts) float64 {
+	best := math.Inf(1)
+	for _, p := range d.lone {
+		for _, q := range other.lone {
+			best = math.Min(best, DistanceSquared(p, q))
+		}
+
+		for _, s := range other.segments {
+			best = math.Min(best, segmentDistanceFromSquared(s[0], s[1], p))
+		}
+	}
+
+	return best
+}
+
+func segmentBound(s [2]orb.Point) orb.Bound {
+	return orb.Bound{Min: s[0], Max: s[0]}.Extend(s[1])
+}
+
+// boundsDistanceSquared returns the squared distance between
+// the nearest points of the two bounds, zero if they overlap.
+func boundsDistanceSquared(a, b orb.Bound) float64 {
+	dx := math.Max(math.Max(a.Min[0]-b.Max[0], 0), b.Min[0]-a.Max[0])
+	dy := math.Max(math.Max(a.Min[1]-b.Max[1], 0), b.Min[1]-a.Max[1])
+
+	return dx*dx + dy*dy
+}
@@ -0,0 +1,113 @@
+package planar
+
+import (
+	"math"
+	"testing"
+
+	"github.com/paulmach/orb"
+)
+
+func TestDistanceBetween(t *testing.T) {
+	square := orb.Polygon{{{0, 0}, {4, 0}, {4, 4}, {0, 4}, {0, 0}}}
+	withHole := orb.Polygon{
+		{{0, 0}, {10, 0}, {10, 10}, {0, 10}, {0, 0}},
+		{{2, 2}, {2, 8}, {8, 8}, {8, 2}, {2, 2}},
+	}
+
+	cases := []struct {
+		name     string
+		a, b     orb.Geometry
+		distance float64
+	}{
+		{
+			name:     "points",
+			a:        orb.Point{0, 0},
+			b:        orb.Point{3, 4},
+			distance: 5,
+		},
+		{
+			name:     "point and line",
+			a:        orb.Point{2, 3},
+			b:        orb.LineString{{0, 0}, {4, 0}},
+			distance: 3,
+		},
+		{
+			name:     "point inside polygon",
+			a:        orb.Point{2, 3},
+			b:        square,
+			distance: 0,
+		},
+		{
+			name:     "point in hole",
+			a:        orb.Point{5, 5},
+			b:        withHole,
+			distance: 3,
+		},
+		{
+			name:     "crossing lines",
+			a:        orb.LineString{{0, 0}, {2, 2}},
+			b:        orb.LineString{{0, 2}, {2, 0}},
+			distance: 0,
+		},
+		{
+			name:     "parallel lines",
+			a:        orb.LineString{{0, 0}, {10, 0}},
+			b:        orb.LineString{{3, 2}, {5, 2}},
+			distance: 2,
+		},
+		{
+			name:     "line through polygon",
+			a:        orb.LineString{{-1, 2}, {5, 2}},
+			b:        square,
+			distance: 0,
+		},
+		{
+			name:     "polygon inside polygon",
+			a:        orb.Polygon{{{1, 1}, {2, 1}, {2, 2}, {1, 1}}},
+			b:        square,
+			distance: 0,
+		},
+		{
+			name:     "polygon in hole",
+			a:        orb.Polygon{{{4, 4}, {6, 4}, {6, 6}, {4, 4}}},
+			b:        withHole,
+			distance: 2,
+		},
+		{
+			name:     "separate polygons",
+			a:        square,
+			b:        orb.Polygon{{{7, 1}, {9, 1}, {9, 3}, {7, 1}}},
+			distance: 3,
+		},
+		{
+			name:     "bound and multi point",
+			a:        orb.Bound{Min: orb.Point{0, 0}, Max: orb.Point{1, 1}},
+			b:        orb.MultiPoint{{5, 5}, {1, 3}},
+			distance: 2,
+		},
+		{
+			name:     "collection",
+			a:        orb.Collection{orb.Point{20, 20}, orb.LineString{{6, 0}, {6, 4}}},
+			b:        square,
+			distance: 2,
+		},
+		{
+			name:     "empty",
+			a:        orb.LineString{},
+			b:        square,
+			distance: math.Inf(1),
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			if d := DistanceBetween(tc.a, tc.b); d != tc.distance {
+				t.Errorf("incorrect distance: %v != %v", d, tc.distance)
+			}
+
+			if d := DistanceBetween(tc.b, tc.a); d != tc.distance {
+				t.Errorf("incorrect reverse distance: %v != %v", d, tc.distance)
+			}
+		})
+	}
+}
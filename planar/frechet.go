@@ -0,0 +1,46 @@
+package planar
+
+import (
+	"math"
+
+	"github.com/paulmach/orb"
+)
+
+// FrechetDistance returns the discrete Fréchet distance between the line
+// strings. Unlike the Hausdorff distance it takes the order of the points into
+// account, making it a good measure of the similarity of trajectories. It is
+// computed with dynamic programming over the vertices of both lines, which is
+// O(n·m) in time and O(m) in memory for lines with n and m points.
+// Two empty lines have a distance of 0, and an empty and non-empty line +Inf.
+func FrechetDistance(a, b orb.LineString) float64 {
+	if len(a) == 0 || len(b) == 0 {
+		if len(a) == len(b) {
+			return 0
+		}
+		return math.Inf(1)
+	}
+
+	// prev[j] and curr[j] hold the squared coupling distance
+	// between a[:i+1] and b[:j+1] for the previous and current rows.
+	prev := make([]float64, len(b))
+	curr := make([]float64, len(b))
+	for i := range a {
+		for j := range b {
+			d := DistanceSquared(a[i], b[j])
+			switch {
+			case i == 0 && j == 0:
+				curr[j] = d
+			case i == 0:
+				curr[j] = math.Max(curr[j-1], d)
+			case j == 0:
+				curr[j] = math.Max(prev[j], d)
+			default:
+				curr[j] = math.Max(math.Min(math.Min(prev[j], prev[j-1]), curr[j-1]), d)
+			}
+		}
+
+		prev, curr = curr, prev
+	}
+
+	return math.Sqrt(prev[len(b)-1])
+}
@@ -0,0 +1,84 @@
+package planar
+
+import (
+	"math"
+	"testing"
+
+	"github.com/paulmach/orb"
+)
+
+func TestFrechetDistance(t *testing.T) {
+	cases := []struct {
+		name     string
+		a, b     orb.LineString
+		distance float64
+	}{
+		{
+			name:     "same line",
+			a:        orb.LineString{{0, 0}, {1, 1}, {2, 0}},
+			b:        orb.LineString{{0, 0}, {1, 1}, {2, 0}},
+			distance: 0,
+		},
+		{
+			name:     "offset",
+			a:        orb.LineString{{0, 0}, {5, 0}, {10, 0}},
+			b:        orb.LineString{{0, 1}, {5, 1}, {10, 1}},
+			distance: 1,
+		},
+		{
+			name:     "reversed",
+			a:        orb.LineString{{0, 0}, {10, 0}},
+			b:        orb.LineString{{10, 0}, {0, 0}},
+			distance: 10,
+		},
+		{
+			name:     "different number of points",
+			a:        orb.LineString{{0, 0}, {10, 0}},
+			b:        orb.LineString{{0, 0}, {4, 0}, {6, 0}, {10, 0}},
+			distance: 4,
+		},
+		{
+			name:     "back and forth",
+			a:        orb.LineString{{0, 0}, {2, 0}, {4, 0}},
+			b:        orb.LineString{{0, 0}, {4, 0}, {0, 0}, {4, 0}},
+			distance: 2,
+		},
+		{
+			name:     "single points",
+			a:        orb.LineString{{3, 4}},
+			b:        orb.LineString{{0, 0}},
+			distance: 5,
+		},
+		{
+			name:     "empty",
+			a:        orb.LineString{},
+			b:        orb.LineString{{0, 0}},
+			distance: math.Inf(1),
+		},
+		{
+			name:     "both empty",
+			a:        nil,
+			b:        orb.LineString{},
+			distance: 0,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			if d := FrechetDistance(tc.a, tc.b); d != tc.distance {
+				t.Errorf("incorrect distance: %v != %v", d, tc.distance)
+			}
+
+			if d := FrechetDistance(tc.b, tc.a); d != tc.distance {
+				t.Errorf("incorrect reverse distance: %v != %v", d, tc.distance)
+			}
+		})
+	}
+
+	// never less than the discrete Hausdorff distance
+	a := orb.LineString{{0, 0}, {10, 0}}
+	b := orb.LineString{{10, 0}, {0, 0}}
+	if f, h := FrechetDistance(a, b), HausdorffDistance(a, b); f < h {
+		t.Errorf("should not be less than hausdorff: %v < %v", f, h)
+	}
+}
@@ -0,0 +1,38 @@
+package planar
+
+import (
+	"math"
+
+	"github.com/paulmach/orb"
+)
+
+// HausdorffDistance returns the Hausdorff distance between the line strings,
+// the maximum over the points of each line of the distance to the other line.
+// Only the vertices are measured against the other line, the discrete
+// Hausdorff distance, which can be less than the exact value when the lines
+// are sampled very differently, e.g. use DensifyMax first if needed. Two empty
+// lines have a distance of 0, and an empty and non-empty line +Inf.
+func HausdorffDistance(a, b orb.LineString) float64 {
+	if len(a) == 0 || len(b) == 0 {
+		if len(a) == len(b) {
+			return 0
+		}
+		return math.Inf(1)
+	}
+
+	return math.Sqrt(math.Max(
+		directedHausdorffSquared(a, b),
+		directedHausdorffSquared(b, a),
+	))
+}
+
+// directedHausdorffSquared returns the maximum squared distance
+// from a point of a to the line string b.
+func directedHausdorffSquared(a, b orb.LineString) float64 {
+	max := 0.0
+	for _, p := range a {
+		max = math.Max(max, lineDistanceSquared(b, p))
+	}
+
+	return max
+}
@@ -0,0 +1,77 @@
+package planar
+
+import (
+	"math"
+	"testing"
+
+	"github.com/paulmach/orb"
+)
+
+func TestHausdorffDistance(t *testing.T) {
+	cases := []struct {
+		name     string
+		a, b     orb.LineString
+		distance float64
+	}{
+		{
+			name:     "same line",
+			a:        orb.LineString{{0, 0}, {1, 1}, {2, 0}},
+			b:        orb.LineString{{0, 0}, {1, 1}, {2, 0}},
+			distance: 0,
+		},
+		{
+			name:     "extra vertices on the line",
+			a:        orb.LineString{{0, 0}, {10, 0}},
+			b:        orb.LineString{{0, 0}, {3, 0}, {7, 0}, {10, 0}},
+			distance: 0,
+		},
+		{
+			name:     "offset",
+			a:        orb.LineString{{0, 0}, {10, 0}},
+			b:        orb.LineString{{0, 1}, {10, 1}},
+			distance: 1,
+		},
+		{
+			name:     "spike",
+			a:        orb.LineString{{0, 0}, {10, 0}},
+			b:        orb.LineString{{0, 0}, {5, 3}, {10, 0}},
+			distance: 3,
+		},
+		{
+			name:     "shorter line",
+			a:        orb.LineString{{0, 0}, {10, 0}},
+			b:        orb.LineString{{0, 0}, {6, 0}},
+			distance: 4,
+		},
+		{
+			name:     "single point",
+			a:        orb.LineString{{3, 4}},
+			b:        orb.LineString{{0, 0}},
+			distance: 5,
+		},
+		{
+			name:     "empty",
+			a:        orb.LineString{},
+			b:        orb.LineString{{0, 0}},
+			distance: math.Inf(1),
+		},
+		{
+			name:     "both empty",
+			a:        orb.LineString{},
+			b:        nil,
+			distance: 0,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			if d := HausdorffDistance(tc.a, tc.b); d != tc.distance {
+				t.Errorf("incorrect distance: %v != %v", d, tc.distance)
+			}
+
+			if d := HausdorffDistance(tc.b, tc.a); d != tc.distance {
+				t.Errorf("incorrect reverse distance: %v != %v", d, tc.distance)
+			}
+		})
+	}
+}
@@ -0,0 +1,259 @@
+package planar
+
+import (
+	"math"
+	"sort"
+
+	"github.com/paulmach/orb"
+)
+
+// ConcaveHull returns a closed counter-clockwise ring outlining the points
+// using the k-nearest neighbors approach by Moreira and Santos. Larger values
+// of k give smoother, more convex results. k is increased if a valid hull can
+// not be found for the given value, by about 1/8 each time so there are only
+// O(log n) retries. Degenerate inputs, e.g. less than 3 distinct
+// points, or inputs where no concave hull can be found fall back to the convex hull.
+func ConcaveHull(mp orb.MultiPoint, k int) orb.Ring {
+	points := uniquePoints(mp)
+	if len(points) < 4 {
+		return convexHull(points)
+	}
+
+	if k < 3 {
+		k = 3
+	}
+
+	for ; k < len(points); k += 1 + k/8 {
+		if r := concaveHull(points, k); r != nil {
+			return r
+		}
+	}
+
+	return convexHull(points)
+}
+
+// concaveHull tries to find the concave hull for the given k.
+// Returns nil if not possible.
+func concaveHull(points []orb.Point, k int) orb.Ring {
+	// start at the lowest point, it's always on the hull.
+	first := 0
+	for i, p := range points {
+		if p[1] < points[first][1] || (p[1] == points[first][1] && p[0] < points[first][0]) {
+			first = i
+		}
+	}
+
+	dataset := make([]orb.Point, 0, len(points))
+	dataset = append(dataset, points[:first]...)
+	dataset = append(dataset, points[first+1:]...)
+
+	start := points[first]
+	hull := orb.Ring{start}
+	current := start
+	back := math.Pi // direction to the "previous" point
+
+	for step := 2; (current != start || step == 2) && len(dataset) > 0; step++ {
+		if step == 5 {
+			dataset = append(dataset, start)
+		}
+
+		candidates := nearestPoints(dataset, current, k)
+		sort.Slice(candidates, func(i, j int) bool {
+			return turnAngle(back, current, candidates[i]) < turnAngle(back, current, candidates[j])
+		})
+
+		found := -1
+		for i, c := range candidates {
+			if !hullCrosses(hull, current, c, c == start) {
+				found = i
+				break
+			}
+		}
+
+		if found == -1 {
+			return nil
+		}
+
+		next := candidates[found]
+		back = math.Atan2(current[1]-next[1], current[0]-next[0])
+		current = next
+		hull = append(hull, current)
+
+		for i, p := range dataset {
+			if p == current {
+				dataset = append(dataset[:i], dataset[i+1:]...)
+				break
+			}
+		}
+	}
+
+	if hull[len(hull)-1] != start {
+		hull = append(hull, start)
+	}
+
+	if len(hull) < 4 {
+		return nil
+	}
+
+	for _, p := range points {
+		if !RingContains(hull, p) {
+			return nil
+		}
+	}
+
+	if hull.Orientation() == orb.CW {
+		hull.Reverse()
+	}
+
+	return hull
+}
+
+// turnAngle returns the counter-clockwise angle, in (0, 2pi], from the
+// back direction to the direction from current to p.
+func turnAngle(back float64, current, p orb.Point) float64 {
+	a := math.Atan2(p[1]-current[1], p[0]-current[0]) - back
+	for a <= 0 {
+		a += 2 * math.Pi
+	}
+
+	for a > 2*math.Pi {
+		a -= 2 * math.Pi
+	}
+
+	return a
+}
+
+// hullCrosses checks if the segment from the last point of the hull to p
+// crosses any of the existing edges of the hull.
+func hullCrosses(hull orb.Ring, current, p orb.Point, closing bool) bool {
+	start := 0
+	if closing {
+		// the first edge shares the start point with the closing segment.
+		start = 1
+	}
+
+	// the last edge shares the current point.
+	for i := start; i < len(hull)-2; i++ {
+		if segmentsCross(current, p, hull[i], hull[i+1]) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// nearestPoints returns the k points closest to the given point, nearest
+// first. A max-heap of the k nearest so far is used, O(n log k), instead
+// of sorting all the points.
+func nearestPoints(points []orb.Point, p orb.Point, k int) []orb.Point {
+	if k <= 0 {
+		return nil
+	}
+
+	type item struct {
+		point    orb.Point
+		distance float64
+	}
+
+	h := make([]item, 0, k)
+
+	// siftDown restores the max-heap property from index i.
+	siftDown := func(i int) {
+		for {
+			largest := i
+			for _, c := range []int{2*i + 1, 2*i + 2} {
+				if c < len(h) && h[c].distance > h[largest].distance {
+					largest = c
+				}
+			}
+
+			if largest == i {
+				return
+			}
+
+			h[i], h[largest] = h[largest], h[i]
+			i = largest
+		}
+	}
+
+	for _, q := range points {
+		d := DistanceSquared(q, p)
+		if len(h) < k {
+			h = append(h, item{point: q, distance: d})
+			for i := len(h) - 1; i > 0 && h[(i-1)/2].distance < h[i].distance; i = (i - 1) / 2 {
+				h[i], h[(i-1)/2] = h[(i-1)/2], h[i]
+			}
+		} else if d < h[0].distance {
+			h[0] = item{point: q, distance: d}
+			siftDown(0)
+		}
+	}
+
+	result := make([]orb.Point, len(h))
+	for i := len(h) - 1; i >= 0; i-- {
+		result[i] = h[0].point
+		h[0] = h[len(h)-1]
+		h = h[:len(h)-1]
+		siftDown(0)
+	}
+
+	return result
+}
+
+// convexHull returns the convex hull of the points as a closed
+// counter-clockwise ring using the monotone chain algorithm.
+func convexHull(points []orb.Point) orb.Ring {
+	if len(points) == 0 {
+		return nil
+	}
+
+	sorted := make([]orb.Point, len(points))
+	copy(sorted, points)
+	sort.Slice(sorted, func(i, j int) bool {
+		if sorted[i][0] == sorted[j][0] {
+			return sorted[i][1] < sorted[j][1]
+		}
+		return sorted[i][0] < sorted[j][0]
+	})
+
+	if len(sorted) < 3 {
+		return append(orb.Ring(sorted), sorted[0])
+	}
+
+	hull := make(orb.Ring, 0, 2*len(sorted))
+
+	// lower hull
+	for _, p := range sorted {
+		for len(hull) >= 2 && cross(hull[len(hull)-2], hull[len(hull)-1], p) <= 0 {
+			hull = hull[:len(hull)-1]
+		}
+		hull = append(hull, p)
+	}
+
+	// upper hull
+	lower := len(hull) + 1
+	for i := len(sorted) - 2; i >= 0; i-- {
+		p := sorted[i]
+		for len(hull) >= lower && cross(hull[len(hull)-2], hull[len(hull)-1], p) <= 0 {
+			hull = hull[:len(hull)-1]
+		}
+		hull = append(hull, p)
+	}
+
+	return hull
+}
+
+func uniquePoints(mp orb.MultiPoint) []orb.Point {
+	seen := make(map[orb.Point]struct{}, len(mp))
+	result := make([]orb.Point, 0, len(mp))
+	for _, p := range mp {
+		if _, ok := seen[p]; ok {
+			continue
+		}
+
+		seen[p] = struct{}{}
+		result = append(result, p)
+	}
+
+	return result
+}
@@ -0,0 +1,86 @@
+package planar
+
+import (
+	"github.com/paulmach/orb"
+)
+
+// A HullBuilder maintains the convex hull of a stream of points.
+// Points inside the current hull are discarded, so the memory used only
+// depends on the size of the hull. Collinear and duplicate points are handled.
+// The zero value is ready to use. It is not safe for concurrent use.
+type HullBuilder struct {
+	// hull is the open counter-clockwise hull without collinear points.
+	hull []orb.Point
+}
+
+// Add updates the hull to include the point.
+func (hb *HullBuilder) Add(p orb.Point) {
+	if len(hb.hull) < 3 {
+		// the hull is degenerate, i.e. one or two points, just recompute it.
+		r := convexHull(uniquePoints(append(hb.hull, p)))
+		hb.hull = append(hb.hull[:0], r[:len(r)-1]...)
+		return
+	}
+
+	h := hb.hull
+	n := len(h)
+
+	// at wraps the index around the hull
+	at := func(i int) orb.Point {
+		return h[((i%n)+n)%n]
+	}
+
+	visible := func(i int) bool {
+		return cross(at(i), at(i+1), p) < 0
+	}
+
+	// find the first edge of the chain of edges that can see the point
+	start := -1
+	for i := 0; i < n; i++ {
+		if visible(i) && !visible(i-1) {
+			start = i
+			break
+		}
+	}
+
+	if start == -1 {
+		// inside or on the boundary of the hull
+		return
+	}
+
+	end := start
+	for visible(end + 1) {
+		end++
+	}
+
+	// vertices collinear with the point and an adjacent edge are removed too
+	for end-start < n-2 && cross(at(start-1), at(start), p) == 0 {
+		start--
+	}
+
+	for end-start < n-2 && cross(at(end+1), at(end+2), p) == 0 {
+		end++
+	}
+
+	// keep the vertices from the end of the chain around to the start
+	result := make([]orb.Point, 0, n+1)
+	for i := end + 1; i <= start+n; i++ {
+		result = append(result, at(i))
+	}
+
+	hb.hull = append(result, p)
+}
+
+// Hull returns the current hull as a new closed counter-clockwise ring.
+// Like ConcaveHull, one or two distinct points result in a degenerate ring.
+// Returns nil if no points have been added.
+func (hb *HullBuilder) Hull() orb.Ring {
+	if len(hb.hull) == 0 {
+		return nil
+	}
+
+	r := make(orb.Ring, 0, len(hb.hull)+1)
+	r = append(r, hb.hull...)
+
+	return append(r, hb.hull[0])
+}
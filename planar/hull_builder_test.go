@@ -0,0 +1,60 @@
+package planar
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/paulmach/orb"
+)
+
+func TestHullBuilder(t *testing.T) {
+	r := rand.New(rand.NewSource(42))
+
+	for i := 0; i < 100; i++ {
+		hb := &HullBuilder{}
+
+		mp := orb.MultiPoint{}
+		for j := 0; j < 100; j++ {
+			// use a grid so there are duplicate and collinear points
+			p := orb.Point{float64(r.Intn(10)), float64(r.Intn(10))}
+			mp = append(mp, p)
+			hb.Add(p)
+
+			expected := convexHull(uniquePoints(mp))
+			if v := hb.Hull(); !v.Canonical().Equal(expected.Canonical()) {
+				t.Fatalf("incorrect hull: %v != %v", v, expected)
+			}
+		}
+	}
+}
+
+func TestHullBuilder_degenerate(t *testing.T) {
+	hb := &HullBuilder{}
+	if v := hb.Hull(); v != nil {
+		t.Errorf("empty builder should return nil: %v", v)
+	}
+
+	hb.Add(orb.Point{1, 1})
+	hb.Add(orb.Point{1, 1})
+	if v := hb.Hull(); !v.Equal(orb.Ring{{1, 1}, {1, 1}}) {
+		t.Errorf("incorrect single point hull: %v", v)
+	}
+
+	hb.Add(orb.Point{3, 3})
+	hb.Add(orb.Point{2, 2})
+	hb.Add(orb.Point{0, 0})
+	if v := hb.Hull(); !v.Equal(orb.Ring{{0, 0}, {3, 3}, {0, 0}}) {
+		t.Errorf("incorrect collinear hull: %v", v)
+	}
+
+	// extending an edge removes the now collinear vertex
+	hb = &HullBuilder{}
+	for _, p := range []orb.Point{{0, 0}, {1, 0}, {1, 1}, {0, 1}, {2, 0}} {
+		hb.Add(p)
+	}
+
+	expected := orb.Ring{{0, 0}, {2, 0}, {1, 1}, {0, 1}, {0, 0}}
+	if v := hb.Hull(); !v.Canonical().Equal(expected) {
+		t.Errorf("incorrect hull: %v != %v", v, expected)
+	}
+}
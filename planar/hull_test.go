@@ -0,0 +1,138 @@
+package planar
+
+import (
+	"math/rand"
+	"sort"
+	"testing"
+
+	"github.com/paulmach/orb"
+)
+
+func TestConcaveHull(t *testing.T) {
+	// a U shape of points, the notch should not be in the hull.
+	mp := orb.MultiPoint{}
+	for x := 0.0; x <= 6; x++ {
+		for y := 0.0; y <= 6; y++ {
+			if x >= 2 && x <= 4 && y >= 2 {
+				continue
+			}
+			mp = append(mp, orb.Point{x, y})
+		}
+	}
+
+	hull := ConcaveHull(mp, 3)
+	if !hull.Closed() {
+		t.Fatalf("hull should be closed: %v", hull)
+	}
+
+	if hull.Orientation() != orb.CCW {
+		t.Errorf("hull should be counter-clockwise")
+	}
+
+	for _, p := range mp {
+		if !RingContains(hull, p) {
+			t.Errorf("hull should contain all points: %v", p)
+		}
+	}
+
+	if RingContains(hull, orb.Point{3, 5}) {
+		t.Errorf("hull should not contain the notch")
+	}
+
+	convex := convexHull(mp)
+	if a, c := Area(hull), Area(convex); a >= c {
+		t.Errorf("concave hull should be smaller than convex hull: %v >= %v", a, c)
+	}
+
+	// large k should give the convex hull
+	if v := ConcaveHull(mp, len(mp)); Area(v) != Area(convex) {
+		t.Errorf("large k should give convex hull: %v", v)
+	}
+}
+
+func TestConcaveHull_random(t *testing.T) {
+	r := rand.New(rand.NewSource(42))
+
+	for i := 0; i < 20; i++ {
+		mp := orb.MultiPoint{}
+		for j := 0; j < 100; j++ {
+			mp = append(mp, orb.Point{r.Float64(), r.Float64()})
+		}
+
+		hull := ConcaveHull(mp, 5)
+		for _, p := range mp {
+			if !RingContains(hull, p) {
+				t.Errorf("%d: hull should contain all points: %v", i, p)
+			}
+		}
+	}
+}
+
+func TestConcaveHull_degenerate(t *testing.T) {
+	cases := []struct {
+		name     string
+		mp       orb.MultiPoint
+		expected orb.Ring
+	}{
+		{
+			name:     "empty",
+			mp:       orb.MultiPoint{},
+			expected: nil,
+		},
+		{
+			name:     "one point",
+			mp:       orb.MultiPoint{{1, 1}},
+			expected: orb.Ring{{1, 1}, {1, 1}},
+		},
+		{
+			name:     "duplicate points",
+			mp:       orb.MultiPoint{{1, 1}, {0, 0}, {1, 1}},
+			expected: orb.Ring{{0, 0}, {1, 1}, {0, 0}},
+		},
+		{
+			name:     "triangle",
+			mp:       orb.MultiPoint{{0, 0}, {0, 1}, {1, 0}},
+			expected: orb.Ring{{0, 0}, {1, 0}, {0, 1}, {0, 0}},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			if v := ConcaveHull(tc.mp, 3); !v.Equal(tc.expected) {
+				t.Errorf("incorrect hull: %v != %v", v, tc.expected)
+			}
+		})
+	}
+}
+
+func TestNearestPoints(t *testing.T) {
+	r := rand.New(rand.NewSource(42))
+
+	points := make([]orb.Point, 200)
+	for i := range points {
+		points[i] = orb.Point{r.Float64(), r.Float64()}
+	}
+
+	for _, k := range []int{0, 1, 5, 50, 200, 300} {
+		p := orb.Point{r.Float64(), r.Float64()}
+		result := nearestPoints(points, p, k)
+
+		expected := append([]orb.Point(nil), points...)
+		sort.Slice(expected, func(i, j int) bool {
+			return DistanceSquared(expected[i], p) < DistanceSquared(expected[j], p)
+		})
+		if len(expected) > k {
+			expected = expected[:k]
+		}
+
+		if len(result) != len(expected) {
+			t.Fatalf("k %d: incorrect number of points: %d != %d", k, len(result), len(expected))
+		}
+
+		for i := range expected {
+			if result[i] != expected[i] {
+				t.Errorf("k %d: incorrect point %d: %v != %v", k, i, result[i], expected[i])
+			}
+		}
+	}
+}
@@ -0,0 +1,75 @@
+package planar
+
+import (
+	"math"
+
+	"github.com/paulmach/orb"
+)
+
+// InsetRing returns the ring offset inward by the given distance, or outward
+// if the distance is negative. Each edge is moved parallel to itself and the
+// new vertices are where the adjacent moved edges meet. The result has the same
+// winding order as the input and is closed. For convex rings and moderate
+// distances the result is exact. Insetting by more than the ring allows,
+// or insetting concave rings, can produce self-intersections that are not
+// cleaned up. Rings with less than 3 distinct points are returned as a copy.
+func InsetRing(r orb.Ring, dist float64) orb.Ring {
+	// distinct consecutive vertices without the closing point
+	pts := make([]orb.Point, 0, len(r))
+	for _, p := range r {
+		if len(pts) == 0 || pts[len(pts)-1] != p {
+			pts = append(pts, p)
+		}
+	}
+
+	for len(pts) > 1 && pts[0] == pts[len(pts)-1] {
+		pts = pts[:len(pts)-1]
+	}
+
+	if len(pts) < 3 {
+		return r.Clone()
+	}
+
+	// the interior is on the left of counter-clockwise rings
+	if ringArea(pts) < 0 {
+		dist = -dist
+	}
+
+	// offset direction of each edge, edge i goes from pts[i] to pts[i+1]
+	normals := make([]orb.Point, len(pts))
+	for i := range pts {
+		a, b := pts[i], pts[(i+1)%len(pts)]
+		dx, dy := b[0]-a[0], b[1]-a[1]
+		l := math.Hypot(dx, dy)
+		normals[i] = orb.Point{-dy / l * dist, dx / l * dist}
+	}
+
+	result := make(orb.Ring, 0, len(pts)+1)
+	for i := range pts {
+		prev := (i + len(pts) - 1) % len(pts)
+
+		a := pts[prev]
+		b := pts[i]
+		c := pts[(i+1)%len(pts)]
+
+		// the previous edge moved, a1-b1, and this edge moved, b2-c2
+		a1 := orb.Point{a[0] + normals[prev][0], a[1] + normals[prev][1]}
+		b1 := orb.Point{b[0] + normals[prev][0], b[1] + normals[prev][1]}
+		b2 := orb.Point{b[0] + normals[i][0], b[1] + normals[i][1]}
+
+		d1 := orb.Point{b1[0] - a1[0], b1[1] - a1[1]}
+		d2 := orb.Point{c[0] - b[0], c[1] - b[1]}
+
+		denom := d1[0]*d2[1] - d1[1]*d2[0]
+		if denom == 0 {
+			// collinear edges, the moved edges meet at the moved vertex
+			result = append(result, b2)
+			continue
+		}
+
+		t := ((b2[0]-a1[0])*d2[1] - (b2[1]-a1[1])*d2[0]) / denom
+		result = append(result, orb.Point{a1[0] + t*d1[0], a1[1] + t*d1[1]})
+	}
+
+	return append(result, result[0])
+}
@@ -0,0 +1,92 @@
+package planar
+
+import (
+	"math"
+	"testing"
+
+	"github.com/paulmach/orb"
+)
+
+func TestInsetRing(t *testing.T) {
+	square := orb.Ring{{0, 0}, {10, 0}, {10, 10}, {0, 10}, {0, 0}}
+
+	cases := []struct {
+		name     string
+		ring     orb.Ring
+		dist     float64
+		expected orb.Ring
+	}{
+		{
+			name:     "inset",
+			ring:     square,
+			dist:     1,
+			expected: orb.Ring{{1, 1}, {9, 1}, {9, 9}, {1, 9}, {1, 1}},
+		},
+		{
+			name:     "outset",
+			ring:     square,
+			dist:     -2,
+			expected: orb.Ring{{-2, -2}, {12, -2}, {12, 12}, {-2, 12}, {-2, -2}},
+		},
+		{
+			name:     "clockwise",
+			ring:     orb.Ring{{0, 0}, {0, 10}, {10, 10}, {10, 0}, {0, 0}},
+			dist:     1,
+			expected: orb.Ring{{1, 1}, {1, 9}, {9, 9}, {9, 1}, {1, 1}},
+		},
+		{
+			name:     "not closed with collinear point",
+			ring:     orb.Ring{{0, 0}, {5, 0}, {10, 0}, {10, 10}, {0, 10}},
+			dist:     1,
+			expected: orb.Ring{{1, 1}, {5, 1}, {9, 1}, {9, 9}, {1, 9}, {1, 1}},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			r := InsetRing(tc.ring, tc.dist)
+			if len(r) != len(tc.expected) {
+				t.Fatalf("incorrect ring: %v != %v", r, tc.expected)
+			}
+
+			for i := range r {
+				if Distance(r[i], tc.expected[i]) > 1e-10 {
+					t.Fatalf("incorrect ring: %v != %v", r, tc.expected)
+				}
+			}
+		})
+	}
+}
+
+func TestInsetRing_triangle(t *testing.T) {
+	// equilateral triangle, insetting keeps the centroid and shrinks the area
+	h := math.Sqrt(3)
+	tri := orb.Ring{{0, 0}, {2, 0}, {1, h}, {0, 0}}
+
+	r := InsetRing(tri, 0.1)
+	if !r.Closed() {
+		t.Errorf("should be closed: %v", r)
+	}
+
+	c1, a1 := CentroidArea(tri)
+	c2, a2 := CentroidArea(r)
+	if Distance(c1, c2) > 1e-10 {
+		t.Errorf("centroid moved: %v != %v", c2, c1)
+	}
+
+	if a2 >= a1 {
+		t.Errorf("area should shrink: %v >= %v", a2, a1)
+	}
+
+	// distance from the centroid to the edges shrinks by the inset
+	if d := DistanceFromSegment(r[0], r[1], c2); math.Abs(d-(h/3-0.1)) > 1e-10 {
+		t.Errorf("incorrect edge distance: %v", d)
+	}
+}
+
+func TestInsetRing_degenerate(t *testing.T) {
+	r := orb.Ring{{1, 1}, {2, 2}, {1, 1}}
+	if v := InsetRing(r, 1); !v.Equal(r) {
+		t.Errorf("should return a copy: %v", v)
+	}
+}
@@ -0,0 +1,139 @@
+package planar
+
+import (
+	"sort"
+
+	"github.com/paulmach/orb"
+)
+
+// Intersections returns the distinct points where the segments of the two
+// line strings intersect, sorted by their position along the first line string.
+// This includes touching endpoints. If segments overlap, the ends of
+// the overlapping section are returned.
+func Intersections(a, b orb.LineString) []orb.Point {
+	if len(a) < 2 || len(b) < 2 || !a.Bound().Intersects(b.Bound()) {
+		return nil
+	}
+
+	bounds := make([]orb.Bound, len(b)-1)
+	for j := range bounds {
+		bounds[j] = orb.MultiPoint{b[j], b[j+1]}.Bound()
+	}
+
+	type intersection struct {
+		point orb.Point
+		index int
+		t     float64
+	}
+
+	var found []intersection
+	for i := 0; i < len(a)-1; i++ {
+		sb := orb.MultiPoint{a[i], a[i+1]}.Bound()
+		for j, bb := range bounds {
+			if !sb.Intersects(bb) {
+				continue
+			}
+
+			for _, t := range segmentIntersections(a[i], a[i+1], b[j], b[j+1]) {
+				// use the vertices directly so they match exactly between segments
+				p := a[i]
+				if t == 1 {
+					p = a[i+1]
+				} else if t != 0 {
+					p = orb.Point{
+						a[i][0] + t*(a[i+1][0]-a[i][0]),
+						a[i][1] + t*(a[i+1][1]-a[i][1]),
+					}
+				}
+
+				found = append(found, intersection{point: p, index: i, t: t})
+			}
+		}
+	}
+
+	sort.SliceStable(found, func(i, j int) bool {
+		if found[i].index != found[j].index {
+			return found[i].index < found[j].index
+		}
+		return found[i].t < found[j].t
+	})
+
+	var result []orb.Point
+	seen := make(map[orb.Point]struct{}, len(found))
+	for _, f := range found {
+		if _, ok := seen[f.point]; ok {
+			continue
+		}
+
+		seen[f.point] = struct{}{}
+		result = append(result, f.point)
+	}
+
+	return result
+}
+
+// segmentIntersections returns the parameters along the segment a1-a2 of its
+// intersection with the segment b1-b2. For collinear overlapping segments the
+// parameters of both ends of the overlap are returned.
+func segmentIntersections(a1, a2, b1, b2 orb.Point) []float64 {
+	rx, ry := a2[0]-a1[0], a2[1]-a1[1]
+	sx, sy := b2[0]-b1[0], b2[1]-b1[1]
+	qx, qy := b1[0]-a1[0], b1[1]-a1[1]
+
+	denom := rx*sy - ry*sx
+	if denom != 0 {
+		t := (qx*sy - qy*sx) / denom
+		u := (qx*ry - qy*rx) / denom
+		if t < 0 || t > 1 || u < 0 || u > 1 {
+			return nil
+		}
+
+		return []float64{t}
+	}
+
+	// parallel, intersect only if collinear
+	if qx*ry-qy*rx != 0 {
+		return nil
+	}
+
+	rr := rx*rx + ry*ry
+	if rr == 0 {
+		// a is a point
+		if sx == 0 && sy == 0 {
+			if a1 == b1 {
+				return []float64{0}
+			}
+			return nil
+		}
+
+		if u := -(qx*sx + qy*sy) / (sx*sx + sy*sy); u >= 0 && u <= 1 {
+			return []float64{0}
+		}
+		return nil
+	}
+
+	// project b onto a and find the overlap
+	t0 := (qx*rx + qy*ry) / rr
+	t1 := t0 + (sx*rx+sy*ry)/rr
+	if t0 > t1 {
+		t0, t1 = t1, t0
+	}
+
+	if t0 > 1 || t1 < 0 {
+		return nil
+	}
+
+	if t0 < 0 {
+		t0 = 0
+	}
+
+	if t1 > 1 {
+		t1 = 1
+	}
+
+	if t0 == t1 {
+		return []float64{t0}
+	}
+
+	return []float64{t0, t1}
+}
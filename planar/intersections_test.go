@@ -0,0 +1,74 @@
+package planar
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/paulmach/orb"
+)
+
+func TestIntersections(t *testing.T) {
+	cases := []struct {
+		name     string
+		a, b     orb.LineString
+		expected []orb.Point
+	}{
+		{
+			name:     "single crossing",
+			a:        orb.LineString{{0, 0}, {2, 2}},
+			b:        orb.LineString{{0, 2}, {2, 0}},
+			expected: []orb.Point{{1, 1}},
+		},
+		{
+			name:     "sorted along a",
+			a:        orb.LineString{{0, 1}, {10, 1}},
+			b:        orb.LineString{{8, 0}, {8, 2}, {2, 2}, {2, 0}, {5, 0}, {5, 2}},
+			expected: []orb.Point{{2, 1}, {5, 1}, {8, 1}},
+		},
+		{
+			name:     "across multiple segments",
+			a:        orb.LineString{{0, 0}, {2, 0}, {2, 2}},
+			b:        orb.LineString{{1, -1}, {1, 1}, {3, 1}},
+			expected: []orb.Point{{1, 0}, {2, 1}},
+		},
+		{
+			name:     "touching at a vertex",
+			a:        orb.LineString{{0, 0}, {1, 1}, {2, 0}},
+			b:        orb.LineString{{1, 1}, {1, 2}},
+			expected: []orb.Point{{1, 1}},
+		},
+		{
+			name:     "collinear overlap",
+			a:        orb.LineString{{0, 0}, {4, 0}},
+			b:        orb.LineString{{3, 0}, {1, 0}},
+			expected: []orb.Point{{1, 0}, {3, 0}},
+		},
+		{
+			name:     "parallel",
+			a:        orb.LineString{{0, 0}, {4, 0}},
+			b:        orb.LineString{{0, 1}, {4, 1}},
+			expected: nil,
+		},
+		{
+			name:     "no overlap",
+			a:        orb.LineString{{0, 0}, {1, 1}},
+			b:        orb.LineString{{5, 5}, {6, 5}},
+			expected: nil,
+		},
+		{
+			name:     "short line",
+			a:        orb.LineString{{0, 0}},
+			b:        orb.LineString{{0, 0}, {1, 1}},
+			expected: nil,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			v := Intersections(tc.a, tc.b)
+			if !reflect.DeepEqual(v, tc.expected) {
+				t.Errorf("incorrect intersections: %v != %v", v, tc.expected)
+			}
+		})
+	}
+}
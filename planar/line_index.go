@@ -0,0 +1,206 @@
+package planar
+
+import (
+	"math"
+	"sort"
+
+	"github.com/paulmach/orb"
+)
+
+// lineIndexNodeSize is the maximum number of children of a LineIndex node.
+const lineIndexNodeSize = 16
+
+// LineIndex is a static spatial index of line strings for repeated nearest
+// line queries. The bounds of the lines are packed into a tree using
+// Sort-Tile-Recursive, built once in O(n log n). Queries visit the nodes
+// nearest first and stop once the k nearest lines are found, so only the
+// lines near the point are measured. The lines must not be modified
+// after the index is built.
+type LineIndex struct {
+	lines  []orb.LineString
+	bounds []orb.Bound
+	nodes  []lineIndexNode
+	root   int
+}
+
+type lineIndexNode struct {
+	bound orb.Bound
+
+	// children are node indexes, or line indexes for leaf nodes.
+	children []int
+	leaf     bool
+}
+
+// NewLineIndex builds an index of the line strings.
+// Empty line strings are ignored.
+func NewLineIndex(lines []orb.LineString) *LineIndex {
+	idx := &LineIndex{
+		lines:  lines,
+		bounds: make([]orb.Bound, len(lines)),
+		root:   -1,
+	}
+
+	entries := make([]int, 0, len(lines))
+	for i, ls := range lines {
+		if len(ls) == 0 {
+			continue
+		}
+
+		idx.bounds[i] = ls.Bound()
+		entries = append(entries, i)
+	}
+
+	if len(entries) == 0 {
+		return idx
+	}
+
+	level := idx.pack(entries, true)
+	for len(level) > 1 {
+		level = idx.pack(level, false)
+	}
+	idx.root = level[0]
+
+	return idx
+}
+
+// pack groups the items, line or node indexes, into nodes of up to
+// lineIndexNodeSize children. Items are sorted into vertical slices by
+// the x of their center, then each slice is sorted by y and cut into
+// nodes. Returns the indexes of the new nodes.
+func (idx *LineIndex) pack(items []int, leaf bool) []int {
+	bound := func(i int) orb.Bound {
+		if leaf {
+			return idx.bounds[i]
+		}
+		return idx.nodes[i].bound
+	}
+
+	center := func(i, axis int) float64 {
+		b := bound(i)
+		return (b.Min[axis] + b.Max[axis]) / 2
+	}
+
+	count := (len(items) + lineIndexNodeSize - 1) / lineIndexNodeSize
+	perSlice := lineIndexNodeSize * int(math.Ceil(math.Sqrt(float64(count))))
+
+	sort.Slice(items, func(i, j int) bool {
+		return center(items[i], 0) < center(items[j], 0)
+	})
+
+	result := make([]int, 0, count)
+	for s := 0; s < len(items); s += perSlice {
+		slice := items[s:minInt(s+perSlice, len(items))]
+		sort.Slice(slice, func(i, j int) bool {
+			return center(slice[i], 1) < center(slice[j], 1)
+		})
+
+		for c := 0; c < len(slice); c += lineIndexNodeSize {
+			children := append([]int(nil), slice[c:minInt(c+lineIndexNodeSize, len(slice))]...)
+
+			b := bound(children[0])
+			for _, i := range children[1:] {
+				b = b.Union(bound(i))
+			}
+
+			idx.nodes = append(idx.nodes, lineIndexNode{
+				bound:    b,
+				children: children,
+				leaf:     leaf,
+			})
+			result = append(result, len(idx.nodes)-1)
+		}
+	}
+
+	return result
+}
+
+// KNearest returns the k line strings with the smallest distance to the
+// point, nearest first. Ties keep the order of the input.
+func (idx *LineIndex) KNearest(p orb.Point, k int) []orb.LineString {
+	if k <= 0 || idx.root < 0 {
+		return nil
+	}
+
+	// Items are nodes or lines keyed by the squared distance to their bound,
+	// a lower bound, until a line is measured and pushed again as exact.
+	type item struct {
+		distance float64
+		index    int
+		node     bool
+		exact    bool
+	}
+
+	less := func(a, b item) bool {
+		if a.distance != b.distance {
+			return a.distance < b.distance
+		}
+
+		// resolve the lower bounds before returning an exact tie
+		if a.exact != b.exact {
+			return !a.exact
+		}
+
+		return a.index < b.index
+	}
+
+	h := []item{{distance: boundDistanceSquared(idx.nodes[idx.root].bound, p), index: idx.root, node: true}}
+	push := func(it item) {
+		h = append(h, it)
+		for i := len(h) - 1; i > 0 && less(h[i], h[(i-1)/2]); i = (i - 1) / 2 {
+			h[i], h[(i-1)/2] = h[(i-1)/2], h[i]
+		}
+	}
+
+	pop := func() item {
+		top := h[0]
+		h[0] = h[len(h)-1]
+		h = h[:len(h)-1]
+
+		for i := 0; ; {
+			smallest := i
+			for _, c := range []int{2*i + 1, 2*i + 2} {
+				if c < len(h) && less(h[c], h[smallest]) {
+					smallest = c
+				}
+			}
+
+			if smallest == i {
+				break
+			}
+
+			h[i], h[smallest] = h[smallest], h[i]
+			i = smallest
+		}
+
+		return top
+	}
+
+	var result []orb.LineString
+	for len(h) > 0 && len(result) < k {
+		it := pop()
+		switch {
+		case it.node:
+			n := idx.nodes[it.index]
+			for _, c := range n.children {
+				if n.leaf {
+					push(item{distance: boundDistanceSquared(idx.bounds[c], p), index: c})
+				} else {
+					push(item{distance: boundDistanceSquared(idx.nodes[c].bound, p), index: c, node: true})
+				}
+			}
+		case !it.exact:
+			push(item{distance: lineDistanceSquared(idx.lines[it.index], p), index: it.index, exact: true})
+		default:
+			result = append(result, idx.lines[it.index])
+		}
+	}
+
+	return result
+}
+
+func minInt(a, b int) int {
+	if a < b {
+		return a
+	}
+	return b
+}
@@ -0,0 +1,105 @@
+package planar
+
+import (
+	"math/rand"
+	"sort"
+	"testing"
+
+	"github.com/paulmach/orb"
+)
+
+func TestLineIndex(t *testing.T) {
+	r := rand.New(rand.NewSource(42))
+
+	lines := make([]orb.LineString, 2000)
+	for i := range lines {
+		start := orb.Point{1000 * r.Float64(), 1000 * r.Float64()}
+		ls := orb.LineString{start}
+		for j := 0; j < 1+r.Intn(5); j++ {
+			last := ls[len(ls)-1]
+			ls = append(ls, orb.Point{last[0] + 20*r.Float64() - 10, last[1] + 20*r.Float64() - 10})
+		}
+		lines[i] = ls
+	}
+	lines[10] = nil
+
+	idx := NewLineIndex(lines)
+
+	// every node should cover its children
+	for _, n := range idx.nodes {
+		for _, c := range n.children {
+			b := idx.bounds[c]
+			if !n.leaf {
+				b = idx.nodes[c].bound
+			}
+
+			if !n.bound.Contains(b.Min) || !n.bound.Contains(b.Max) {
+				t.Fatalf("node bound %v does not cover child %v", n.bound, b)
+			}
+		}
+	}
+
+	for i := 0; i < 50; i++ {
+		p := orb.Point{1000 * r.Float64(), 1000 * r.Float64()}
+
+		// brute force
+		expected := make([]orb.LineString, 0, len(lines))
+		for _, ls := range lines {
+			if len(ls) > 0 {
+				expected = append(expected, ls)
+			}
+		}
+
+		sort.SliceStable(expected, func(i, j int) bool {
+			return DistanceFrom(expected[i], p) < DistanceFrom(expected[j], p)
+		})
+
+		result := idx.KNearest(p, 10)
+		if len(result) != 10 {
+			t.Fatalf("incorrect number of lines: %d", len(result))
+		}
+
+		for j := range result {
+			if DistanceFrom(result[j], p) != DistanceFrom(expected[j], p) {
+				t.Fatalf("incorrect line %d for %v: %v != %v", j, p, result[j], expected[j])
+			}
+		}
+	}
+
+	if v := idx.KNearest(orb.Point{}, len(lines)); len(v) != len(lines)-1 {
+		t.Errorf("should return all the non empty lines: %d", len(v))
+	}
+}
+
+func TestLineIndex_ties(t *testing.T) {
+	lines := make([]orb.LineString, 0, 100)
+	for i := 0; i < 100; i++ {
+		// unit lines along x = 1 and x = -1
+		lines = append(lines, orb.LineString{{1, float64(i - 50)}, {1, float64(i - 49)}})
+		lines = append(lines, orb.LineString{{-1, float64(i - 50)}, {-1, float64(i - 49)}})
+	}
+
+	result := NewLineIndex(lines).KNearest(orb.Point{0, 0}, 4)
+
+	// the four lines with x = +-1 touching y = 0, in input order
+	expected := []orb.LineString{lines[98], lines[99], lines[100], lines[101]}
+	for i := range expected {
+		if !result[i].Equal(expected[i]) {
+			t.Errorf("incorrect line %d: %v != %v", i, result[i], expected[i])
+		}
+	}
+}
+
+func TestLineIndex_empty(t *testing.T) {
+	cases := [][]orb.LineString{
+		nil,
+		{},
+		{{}, nil},
+	}
+
+	for i, lines := range cases {
+		if v := NewLineIndex(lines).KNearest(orb.Point{}, 3); v != nil {
+			t.Errorf("%d: should return nil: %v", i, v)
+		}
+	}
+}
@@ -0,0 +1,63 @@
+package planar
+
+import (
+	"math"
+
+	"github.com/paulmach/orb"
+)
+
+// LocatePoint projects the point onto the nearest point of the line string
+// and returns the station, the distance along the line string to that point,
+// and the offset, the distance from the line string to the point. The offset
+// is positive if the point is to the left of the line string, in the direction
+// of the line, and negative if to the right. If several points are equally near
+// the one with the smallest station is used. An empty line string returns 0, 0.
+func LocatePoint(ls orb.LineString, p orb.Point) (float64, float64) {
+	if len(ls) == 0 {
+		return 0, 0
+	}
+
+	if len(ls) == 1 {
+		return 0, Distance(ls[0], p)
+	}
+
+	station, offset := 0.0, 0.0
+	best := math.Inf(1)
+
+	traveled := 0.0
+	for i := 0; i < len(ls)-1; i++ {
+		a, b := ls[i], ls[i+1]
+		dx, dy := b[0]-a[0], b[1]-a[1]
+		l := math.Sqrt(dx*dx + dy*dy)
+		if l == 0 {
+			continue
+		}
+
+		t := ((p[0]-a[0])*dx + (p[1]-a[1])*dy) / (l * l)
+		if t < 0 {
+			t = 0
+		} else if t > 1 {
+			t = 1
+		}
+
+		px, py := p[0]-(a[0]+t*dx), p[1]-(a[1]+t*dy)
+		if d := px*px + py*py; d < best {
+			best = d
+			station = traveled + t*l
+
+			offset = math.Sqrt(d)
+			if dx*py-dy*px < 0 {
+				offset = -offset
+			}
+		}
+
+		traveled += l
+	}
+
+	if math.IsInf(best, 1) {
+		// all the points are the same
+		return 0, Distance(ls[0], p)
+	}
+
+	return station, offset
+}
@@ -0,0 +1,90 @@
+package planar
+
+import (
+	"math"
+	"testing"
+
+	"github.com/paulmach/orb"
+)
+
+func TestLocatePoint(t *testing.T) {
+	ls := orb.LineString{{0, 0}, {10, 0}, {10, 10}}
+
+	cases := []struct {
+		name    string
+		ls      orb.LineString
+		point   orb.Point
+		station float64
+		offset  float64
+	}{
+		{
+			name:    "left of first segment",
+			ls:      ls,
+			point:   orb.Point{3, 2},
+			station: 3,
+			offset:  2,
+		},
+		{
+			name:    "right of second segment",
+			ls:      ls,
+			point:   orb.Point{13, 4},
+			station: 14,
+			offset:  -3,
+		},
+		{
+			name:    "on the line",
+			ls:      ls,
+			point:   orb.Point{10, 5},
+			station: 15,
+			offset:  0,
+		},
+		{
+			name:    "before the start",
+			ls:      ls,
+			point:   orb.Point{-3, -4},
+			station: 0,
+			offset:  -5,
+		},
+		{
+			name:    "after the end",
+			ls:      ls,
+			point:   orb.Point{10, 12},
+			station: 20,
+			offset:  2,
+		},
+		{
+			name:    "repeated points",
+			ls:      orb.LineString{{0, 0}, {0, 0}, {0, 10}, {0, 10}, {5, 10}},
+			point:   orb.Point{2, 11},
+			station: 12,
+			offset:  1,
+		},
+		{
+			name:    "single point",
+			ls:      orb.LineString{{1, 1}},
+			point:   orb.Point{4, 5},
+			station: 0,
+			offset:  5,
+		},
+		{
+			name:    "empty",
+			ls:      orb.LineString{},
+			point:   orb.Point{4, 5},
+			station: 0,
+			offset:  0,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			station, offset := LocatePoint(tc.ls, tc.point)
+			if math.Abs(station-tc.station) > 1e-10 {
+				t.Errorf("incorrect station: %v != %v", station, tc.station)
+			}
+
+			if math.Abs(offset-tc.offset) > 1e-10 {
+				t.Errorf("incorrect offset: %v != %v", offset, tc.offset)
+			}
+		})
+	}
+}
@@ -0,0 +1,76 @@
+package planar
+
+import (
+	"github.com/paulmach/orb"
+)
+
+// MinkowskiSum returns the Minkowski sum of the two convex rings, ie. the set of
+// all the points a + b for a in the first ring and b in the second.
+// The result is a closed counter-clockwise ring. Only convex input is supported,
+// for non-convex rings the result is the sum of their convex hulls.
+// The edges of the two hulls are merged by angle, so after the hulls this is
+// O(n + m). Empty rings return nil.
+func MinkowskiSum(a, b orb.Ring) orb.Ring {
+	if len(a) == 0 || len(b) == 0 {
+		return nil
+	}
+
+	pa := minkowskiVertices(a)
+	pb := minkowskiVertices(b)
+
+	n, m := len(pa), len(pb)
+	result := make(orb.Ring, 0, n+m+1)
+	for i, j := 0, 0; i < n || j < m; {
+		result = append(result, orb.Point{pa[i%n][0] + pb[j%m][0], pa[i%n][1] + pb[j%m][1]})
+
+		if i == n {
+			j++
+			continue
+		}
+
+		if j == m {
+			i++
+			continue
+		}
+
+		// advance along the edge with the smaller angle, or both if parallel
+		ea := orb.Point{pa[(i+1)%n][0] - pa[i%n][0], pa[(i+1)%n][1] - pa[i%n][1]}
+		eb := orb.Point{pb[(j+1)%m][0] - pb[j%m][0], pb[(j+1)%m][1] - pb[j%m][1]}
+		c := ea[0]*eb[1] - ea[1]*eb[0]
+		if c >= 0 {
+			i++
+		}
+
+		if c <= 0 {
+			j++
+		}
+	}
+
+	// start at the same point as the convex hull would
+	first := 0
+	for i, p := range result {
+		if p[0] < result[first][0] || (p[0] == result[first][0] && p[1] < result[first][1]) {
+			first = i
+		}
+	}
+
+	result = append(result[first:], result[:first]...)
+	return append(result, result[0])
+}
+
+// minkowskiVertices returns the vertices of the convex hull of the ring,
+// counter-clockwise without the closing point, starting at the lowest point.
+// Starting both rings in the same direction lets their edges be merged.
+func minkowskiVertices(r orb.Ring) []orb.Point {
+	hull := convexHull(uniquePoints(orb.MultiPoint(r)))
+	hull = hull[:len(hull)-1]
+
+	first := 0
+	for i, p := range hull {
+		if p[1] < hull[first][1] || (p[1] == hull[first][1] && p[0] < hull[first][0]) {
+			first = i
+		}
+	}
+
+	return append(hull[first:], hull[:first]...)
+}
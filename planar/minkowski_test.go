@@ -0,0 +1,63 @@
+package planar
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/paulmach/orb"
+)
+
+func TestMinkowskiSum(t *testing.T) {
+	square := orb.Ring{{0, 0}, {1, 0}, {1, 1}, {0, 1}, {0, 0}}
+	triangle := orb.Ring{{0, 0}, {2, 0}, {0, 2}, {0, 0}}
+
+	sum := MinkowskiSum(square, triangle)
+	expected := orb.Ring{{0, 0}, {3, 0}, {3, 1}, {1, 3}, {0, 3}, {0, 0}}
+	if !sum.Equal(expected) {
+		t.Errorf("incorrect sum: %v != %v", sum, expected)
+	}
+
+	if a := Area(sum); a != 7 {
+		t.Errorf("incorrect area: %v", a)
+	}
+
+	// sum with a point is a translation
+	sum = MinkowskiSum(square, orb.Ring{{5, 5}})
+	expected = orb.Ring{{5, 5}, {6, 5}, {6, 6}, {5, 6}, {5, 5}}
+	if !sum.Equal(expected) {
+		t.Errorf("incorrect translation: %v != %v", sum, expected)
+	}
+
+	if v := MinkowskiSum(square, nil); v != nil {
+		t.Errorf("empty ring should return nil: %v", v)
+	}
+}
+
+func TestMinkowskiSum_random(t *testing.T) {
+	r := rand.New(rand.NewSource(42))
+
+	randomRing := func() orb.Ring {
+		ring := orb.Ring{}
+		for i := 0; i < 1+r.Intn(20); i++ {
+			ring = append(ring, orb.Point{float64(r.Intn(20)), float64(r.Intn(20))})
+		}
+		return append(ring, ring[0])
+	}
+
+	for i := 0; i < 100; i++ {
+		a, b := randomRing(), randomRing()
+
+		// the hull of all the pairwise sums
+		var sums []orb.Point
+		for _, pa := range a {
+			for _, pb := range b {
+				sums = append(sums, orb.Point{pa[0] + pb[0], pa[1] + pb[1]})
+			}
+		}
+		expected := convexHull(uniquePoints(sums))
+
+		if sum := MinkowskiSum(a, b); !sum.Equal(expected) {
+			t.Errorf("incorrect sum of %v and %v: %v != %v", a, b, sum, expected)
+		}
+	}
+}
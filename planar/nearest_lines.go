@@ -0,0 +1,42 @@
+package planar
+
+import (
+	"math"
+
+	"github.com/paulmach/orb"
+)
+
+// KNearestLines returns the k line strings with the smallest distance to the
+// point, nearest first. Ties keep the order of the input. Empty line strings
+// are ignored. The lines are indexed by their bounds, see LineIndex, so only
+// the lines near the point are measured. Building the index is O(n log n),
+// use NewLineIndex directly to query the same lines many times.
+func KNearestLines(lines []orb.LineString, p orb.Point, k int) []orb.LineString {
+	if k <= 0 {
+		return nil
+	}
+
+	return NewLineIndex(lines).KNearest(p, k)
+}
+
+// boundDistanceSquared returns the squared distance from the point
+// to the nearest point in the bound, zero if the point is inside.
+func boundDistanceSquared(b orb.Bound, p orb.Point) float64 {
+	dx := math.Max(math.Max(b.Min[0]-p[0], 0), p[0]-b.Max[0])
+	dy := math.Max(math.Max(b.Min[1]-p[1], 0), p[1]-b.Max[1])
+
+	return dx*dx + dy*dy
+}
+
+func lineDistanceSquared(ls orb.LineString, p orb.Point) float64 {
+	if len(ls) == 1 {
+		return DistanceSquared(ls[0], p)
+	}
+
+	d := math.Inf(1)
+	for i := 0; i < len(ls)-1; i++ {
+		d = math.Min(d, segmentDistanceFromSquared(ls[i], ls[i+1], p))
+	}
+
+	return d
+}
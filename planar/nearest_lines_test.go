@@ -0,0 +1,72 @@
+package planar
+
+import (
+	"math/rand"
+	"sort"
+	"testing"
+
+	"github.com/paulmach/orb"
+)
+
+func TestKNearestLines(t *testing.T) {
+	lines := []orb.LineString{
+		{{0, 10}, {10, 10}},
+		{{-5, -5}, {5, -5}},
+		{{2, 0}, {2, 1}},
+		{{-100, 1}, {100, 1}}, // long line with far away vertices
+		{},
+		{{0, 3}},
+	}
+
+	result := KNearestLines(lines, orb.Point{0, 0}, 3)
+	expected := []orb.LineString{lines[3], lines[2], lines[5]}
+	if len(result) != len(expected) {
+		t.Fatalf("incorrect number of lines: %v", result)
+	}
+
+	for i := range expected {
+		if !result[i].Equal(expected[i]) {
+			t.Errorf("incorrect line %d: %v != %v", i, result[i], expected[i])
+		}
+	}
+
+	if v := KNearestLines(lines, orb.Point{0, 0}, 10); len(v) != 5 {
+		t.Errorf("should return all the non empty lines: %v", v)
+	}
+
+	if v := KNearestLines(lines, orb.Point{0, 0}, 0); v != nil {
+		t.Errorf("should return nil for k = 0: %v", v)
+	}
+}
+
+func TestKNearestLines_random(t *testing.T) {
+	r := rand.New(rand.NewSource(42))
+
+	lines := make([]orb.LineString, 500)
+	for i := range lines {
+		start := orb.Point{100 * r.Float64(), 100 * r.Float64()}
+		ls := orb.LineString{start}
+		for j := 0; j < 1+r.Intn(5); j++ {
+			last := ls[len(ls)-1]
+			ls = append(ls, orb.Point{last[0] + 10*r.Float64() - 5, last[1] + 10*r.Float64() - 5})
+		}
+		lines[i] = ls
+	}
+
+	for i := 0; i < 50; i++ {
+		p := orb.Point{100 * r.Float64(), 100 * r.Float64()}
+
+		// brute force
+		expected := append([]orb.LineString(nil), lines...)
+		sort.SliceStable(expected, func(i, j int) bool {
+			return DistanceFrom(expected[i], p) < DistanceFrom(expected[j], p)
+		})
+
+		result := KNearestLines(lines, p, 10)
+		for j := range result {
+			if DistanceFrom(result[j], p) != DistanceFrom(expected[j], p) {
+				t.Fatalf("incorrect line %d for %v: %v != %v", j, p, result[j], expected[j])
+			}
+		}
+	}
+}
@@ -0,0 +1,186 @@
+package planar
+
+import (
+	"fmt"
+
+	"github.com/paulmach/orb"
+)
+
+// Relationship describes how two geometries relate spatially,
+// loosely following the DE-9IM named predicates.
+type Relationship struct {
+	// Disjoint is true if the geometries have no point in common.
+	Disjoint bool
+
+	// Intersects is true if the geometries have at least one point in common.
+	Intersects bool
+
+	// Contains is true if the second geometry lies completely within the first.
+	Contains bool
+
+	// Within is true if the first geometry lies completely within the second.
+	Within bool
+
+	// Touches is true if the geometries only have boundary points in common.
+	Touches bool
+
+	// Overlaps is true if the interiors intersect but
+	// neither geometry contains the other.
+	Overlaps bool
+}
+
+// Relate returns the relationship between the two geometries. Supported are
+// points and polygons, rings and bounds are treated as polygons.
+// Other geometry types will panic.
+func Relate(a, b orb.Geometry) Relationship {
+	pa, aIsPolygon := relatePolygon(a)
+	pb, bIsPolygon := relatePolygon(b)
+
+	switch {
+	case aIsPolygon && bIsPolygon:
+		return relatePolygons(pa, pb)
+	case aIsPolygon:
+		if p, ok := b.(orb.Point); ok {
+			return relatePolygonPoint(pa, p)
+		}
+	case bIsPolygon:
+		if p, ok := a.(orb.Point); ok {
+			r := relatePolygonPoint(pb, p)
+			r.Contains, r.Within = r.Within, r.Contains
+			return r
+		}
+	default:
+		p1, ok1 := a.(orb.Point)
+		p2, ok2 := b.(orb.Point)
+		if ok1 && ok2 {
+			if p1 == p2 {
+				return Relationship{Intersects: true, Contains: true, Within: true}
+			}
+			return Relationship{Disjoint: true}
+		}
+	}
+
+	panic(fmt.Sprintf("geometry types not supported: %T, %T", a, b))
+}
+
+func relatePolygon(g orb.Geometry) (orb.Polygon, bool) {
+	switch g := g.(type) {
+	case orb.Polygon:
+		return g, true
+	case orb.Ring:
+		return orb.Polygon{g}, true
+	case orb.Bound:
+		return g.ToPolygon(), true
+	}
+
+	return nil, false
+}
+
+func relatePolygonPoint(p orb.Polygon, point orb.Point) Relationship {
+	// check the boundaries first, PolygonContains is false for
+	// a point on the boundary of a hole.
+	for _, r := range p {
+		if ringBoundaryContains(r, point) {
+			return Relationship{Intersects: true, Touches: true}
+		}
+	}
+
+	if len(p) == 0 || !PolygonContains(p, point) {
+		return Relationship{Disjoint: true}
+	}
+
+	return Relationship{Intersects: true, Contains: true}
+}
+
+func relatePolygons(a, b orb.Polygon) Relationship {
+	if len(a) == 0 || len(b) == 0 || !a.Bound().Intersects(b.Bound()) {
+		return Relationship{Disjoint: true}
+	}
+
+	if !polygonsIntersect(a, b) {
+		return Relationship{Disjoint: true}
+	}
+
+	r := Relationship{
+		Intersects: true,
+		Within:     polygonWithin(a, b),
+		Contains:   polygonWithin(b, a),
+	}
+
+	interiors := r.Within || r.Contains ||
+		edgesCross(a, b) ||
+		interiorPointIn(a, b) || interiorPointIn(b, a)
+
+	r.Touches = !interiors
+	r.Overlaps = interiors && !r.Within && !r.Contains
+
+	return r
+}
+
+// polygonsIntersect returns true if the polygons have any point in common.
+func polygonsIntersect(a, b orb.Polygon) bool {
+	for _, ra := range a {
+		for _, rb := range b {
+			for i := 0; i < len(ra)-1; i++ {
+				for j := 0; j < len(rb)-1; j++ {
+					if len(segmentIntersections(ra[i], ra[i+1], rb[j], rb[j+1])) > 0 {
+						return true
+					}
+				}
+			}
+		}
+	}
+
+	// no boundaries intersect so one could be completely inside the other.
+	if len(a) > 0 && len(a[0]) > 0 && PolygonContains(b, a[0][0]) {
+		return true
+	}
+
+	return len(b) > 0 && len(b[0]) > 0 && PolygonContains(a, b[0][0])
+}
+
+// edgesCross returns true if any of the edges properly cross.
+func edgesCross(a, b orb.Polygon) bool {
+	for _, ra := range a {
+		for _, rb := range b {
+			for i := 0; i < len(ra)-1; i++ {
+				for j := 0; j < len(rb)-1; j++ {
+					if segmentsCross(ra[i], ra[i+1], rb[j], rb[j+1]) {
+						return true
+					}
+				}
+			}
+		}
+	}
+
+	return false
+}
+
+// interiorPointIn returns true if a vertex or edge midpoint of the
+// first polygon is strictly inside, not on the boundary of, the second.
+func interiorPointIn(a, b orb.Polygon) bool {
+	strictlyIn := func(p orb.Point) bool {
+		if !PolygonContains(b, p) {
+			return false
+		}
+
+		for _, r := range b {
+			if ringBoundaryContains(r, p) {
+				return false
+			}
+		}
+
+		return true
+	}
+
+	for _, r := range a {
+		for i := 0; i < len(r)-1; i++ {
+			mid := orb.Point{(r[i][0] + r[i+1][0]) / 2, (r[i][1] + r[i+1][1]) / 2}
+			if strictlyIn(r[i]) || strictlyIn(mid) {
+				return true
+			}
+		}
+	}
+
+	return false
+}
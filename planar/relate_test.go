@@ -0,0 +1,160 @@
+package planar
+
+import (
+	"testing"
+
+	"github.com/paulmach/orb"
+)
+
+func TestRelate(t *testing.T) {
+	square := orb.Polygon{{{0, 0}, {4, 0}, {4, 4}, {0, 4}, {0, 0}}}
+	withHole := orb.Polygon{
+		{{0, 0}, {10, 0}, {10, 10}, {0, 10}, {0, 0}},
+		{{2, 2}, {8, 2}, {8, 8}, {2, 8}, {2, 2}},
+	}
+
+	cases := []struct {
+		name     string
+		a, b     orb.Geometry
+		expected Relationship
+	}{
+		{
+			name:     "point inside",
+			a:        square,
+			b:        orb.Point{1, 1},
+			expected: Relationship{Intersects: true, Contains: true},
+		},
+		{
+			name:     "point within",
+			a:        orb.Point{1, 1},
+			b:        square,
+			expected: Relationship{Intersects: true, Within: true},
+		},
+		{
+			name:     "point on boundary",
+			a:        square,
+			b:        orb.Point{4, 2},
+			expected: Relationship{Intersects: true, Touches: true},
+		},
+		{
+			name:     "point on hole boundary",
+			a:        withHole,
+			b:        orb.Point{2, 5},
+			expected: Relationship{Intersects: true, Touches: true},
+		},
+		{
+			name:     "point within hole boundary",
+			a:        orb.Point{2, 5},
+			b:        withHole,
+			expected: Relationship{Intersects: true, Touches: true},
+		},
+		{
+			name:     "point in hole",
+			a:        withHole,
+			b:        orb.Point{5, 5},
+			expected: Relationship{Disjoint: true},
+		},
+		{
+			name:     "point outside",
+			a:        square,
+			b:        orb.Point{5, 5},
+			expected: Relationship{Disjoint: true},
+		},
+		{
+			name:     "point in hole",
+			a:        withHole,
+			b:        orb.Point{5, 5},
+			expected: Relationship{Disjoint: true},
+		},
+		{
+			name:     "polygon contains",
+			a:        square,
+			b:        orb.Ring{{1, 1}, {2, 1}, {2, 2}, {1, 1}},
+			expected: Relationship{Intersects: true, Contains: true},
+		},
+		{
+			name:     "polygon within sharing boundary",
+			a:        orb.Bound{Min: orb.Point{0, 0}, Max: orb.Point{2, 2}},
+			b:        square,
+			expected: Relationship{Intersects: true, Within: true},
+		},
+		{
+			name:     "equal",
+			a:        square,
+			b:        square,
+			expected: Relationship{Intersects: true, Within: true, Contains: true},
+		},
+		{
+			name:     "overlaps",
+			a:        square,
+			b:        orb.Bound{Min: orb.Point{2, 2}, Max: orb.Point{6, 6}},
+			expected: Relationship{Intersects: true, Overlaps: true},
+		},
+		{
+			name:     "touches along an edge",
+			a:        square,
+			b:        orb.Bound{Min: orb.Point{4, 1}, Max: orb.Point{6, 3}},
+			expected: Relationship{Intersects: true, Touches: true},
+		},
+		{
+			name:     "touches at a corner",
+			a:        square,
+			b:        orb.Bound{Min: orb.Point{4, 4}, Max: orb.Point{6, 6}},
+			expected: Relationship{Intersects: true, Touches: true},
+		},
+		{
+			name:     "disjoint",
+			a:        square,
+			b:        orb.Bound{Min: orb.Point{5, 5}, Max: orb.Point{6, 6}},
+			expected: Relationship{Disjoint: true},
+		},
+		{
+			name:     "inside the hole",
+			a:        withHole,
+			b:        orb.Bound{Min: orb.Point{3, 3}, Max: orb.Point{4, 4}},
+			expected: Relationship{Disjoint: true},
+		},
+		{
+			name:     "filling the hole",
+			a:        withHole,
+			b:        orb.Bound{Min: orb.Point{2, 2}, Max: orb.Point{8, 8}},
+			expected: Relationship{Intersects: true, Touches: true},
+		},
+		{
+			name:     "empty outer ring",
+			a:        orb.Polygon{{}, {{0, 0}, {1, 0}, {1, 1}, {0, 0}}},
+			b:        orb.Polygon{{{-2, -2}, {2, -2}, {2, 2}, {-2, 2}, {-2, -2}}},
+			expected: Relationship{Disjoint: true},
+		},
+		{
+			name:     "empty outer ring second",
+			a:        orb.Polygon{{{-2, -2}, {2, -2}, {2, 2}, {-2, 2}, {-2, -2}}},
+			b:        orb.Polygon{{}, {{0, 0}, {1, 0}, {1, 1}, {0, 0}}},
+			expected: Relationship{Disjoint: true},
+		},
+		{
+			name:     "same points",
+			a:        orb.Point{1, 1},
+			b:        orb.Point{1, 1},
+			expected: Relationship{Intersects: true, Contains: true, Within: true},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			if v := Relate(tc.a, tc.b); v != tc.expected {
+				t.Errorf("incorrect relationship: %+v != %+v", v, tc.expected)
+			}
+		})
+	}
+}
+
+func TestRelate_unsupported(t *testing.T) {
+	defer func() {
+		if r := recover(); r == nil {
+			t.Errorf("should panic for unsupported types")
+		}
+	}()
+
+	Relate(orb.LineString{{0, 0}, {1, 1}}, orb.Point{})
+}
@@ -0,0 +1,109 @@
+package planar
+
+import (
+	"math"
+
+	"github.com/paulmach/orb"
+)
+
+// MinimumRotatedRect returns the minimum area, possibly rotated, rectangle
+// containing all the points. The result is a polygon with a closed counter-clockwise
+// ring of the 4 corners. One side of the minimum rectangle is always collinear with
+// an edge of the convex hull so only those orientations are checked, using rotating
+// calipers. This is O(n log n) for the hull and O(h) for the h hull points.
+// If the points are collinear the rectangle is degenerate with no width,
+// a single distinct point returns a ring of that point. Empty input returns nil.
+func MinimumRotatedRect(mp orb.MultiPoint) orb.Polygon {
+	if len(mp) == 0 {
+		return nil
+	}
+
+	hull := convexHull(uniquePoints(mp))
+	hull = hull[:len(hull)-1] // the closing point
+
+	if len(hull) == 1 {
+		p := hull[0]
+		return orb.Polygon{{p, p, p, p, p}}
+	}
+
+	n := len(hull)
+	next := func(i int) int { return (i + 1) % n }
+
+	var (
+		best     orb.Ring
+		bestArea = math.Inf(1)
+	)
+
+	// the calipers, indexes of the hull points with the max and min
+	// projection along the edge and the max distance from the edge.
+	// They only move forward as the edge rotates counter-clockwise.
+	maxU, minU, maxV := -1, -1, -1
+
+	for i := range hull {
+		a, b := hull[i], hull[next(i)]
+
+		l := Distance(a, b)
+		if l == 0 {
+			continue
+		}
+
+		// unit vectors along the edge and perpendicular to it
+		ex, ey := (b[0]-a[0])/l, (b[1]-a[1])/l
+		nx, ny := -ey, ex
+
+		u := func(j int) float64 {
+			return (hull[j][0]-a[0])*ex + (hull[j][1]-a[1])*ey
+		}
+		v := func(j int) float64 {
+			return (hull[j][0]-a[0])*nx + (hull[j][1]-a[1])*ny
+		}
+
+		if maxU == -1 {
+			// full scan to place the calipers for the first edge
+			maxU, minU, maxV = 0, 0, 0
+			for j := range hull {
+				if u(j) > u(maxU) {
+					maxU = j
+				}
+				if u(j) < u(minU) {
+					minU = j
+				}
+				if v(j) > v(maxV) {
+					maxV = j
+				}
+			}
+		} else {
+			for c := 0; c < n && u(next(maxU)) > u(maxU); c++ {
+				maxU = next(maxU)
+			}
+			for c := 0; c < n && u(next(minU)) < u(minU); c++ {
+				minU = next(minU)
+			}
+			for c := 0; c < n && v(next(maxV)) > v(maxV); c++ {
+				maxV = next(maxV)
+			}
+		}
+
+		// the hull is counter-clockwise so all the points are
+		// on the left of the edge, the minimum distance is 0.
+		area := (u(maxU) - u(minU)) * v(maxV)
+		if area >= bestArea {
+			continue
+		}
+
+		corner := func(u, v float64) orb.Point {
+			return orb.Point{a[0] + u*ex + v*nx, a[1] + u*ey + v*ny}
+		}
+
+		bestArea = area
+		best = orb.Ring{
+			corner(u(minU), 0),
+			corner(u(maxU), 0),
+			corner(u(maxU), v(maxV)),
+			corner(u(minU), v(maxV)),
+			corner(u(minU), 0),
+		}
+	}
+
+	return orb.Polygon{best}
+}
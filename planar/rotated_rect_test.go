@@ -0,0 +1,116 @@
+package planar
+
+import (
+	"math"
+	"math/rand"
+	"testing"
+
+	"github.com/paulmach/orb"
+)
+
+func TestMinimumRotatedRect(t *testing.T) {
+	// a diagonal 4x1 rectangle rotated 45 degrees, with points inside
+	s := math.Sqrt2 / 2
+	mp := orb.MultiPoint{
+		{0, 0},
+		{4 * s, 4 * s},
+		{4*s - s, 4*s + s},
+		{-s, s},
+		{2 * s, 2 * s},
+		{s, 1.5 * s},
+	}
+
+	rect := MinimumRotatedRect(mp)
+	if len(rect) != 1 || len(rect[0]) != 5 {
+		t.Fatalf("should be a polygon with 4 corners: %v", rect)
+	}
+
+	if a := Area(rect); math.Abs(a-4) > 1e-9 {
+		t.Errorf("incorrect area: %v", a)
+	}
+
+	if rect[0].Orientation() != orb.CCW {
+		t.Errorf("should be counter-clockwise")
+	}
+
+	// smaller than the axis aligned bound
+	if a, b := Area(rect), Area(mp.Bound()); a >= b {
+		t.Errorf("should be smaller than the bound: %v >= %v", a, b)
+	}
+
+	// all points are inside, allowing for round off
+	for _, p := range mp {
+		if !RingContains(rect[0], p) {
+			c := rect[0]
+			onEdge := false
+			for i := 0; i < len(c)-1; i++ {
+				onEdge = onEdge || DistanceFromSegment(c[i], c[i+1], p) < 1e-9
+			}
+
+			if !onEdge {
+				t.Errorf("point should be in rectangle: %v", p)
+			}
+		}
+	}
+}
+
+func TestMinimumRotatedRect_degenerate(t *testing.T) {
+	rect := MinimumRotatedRect(orb.MultiPoint{{0, 0}, {1, 1}, {2, 2}})
+	if a := Area(rect); a != 0 {
+		t.Errorf("collinear points should have no area: %v", a)
+	}
+
+	b := rect.Bound()
+	if Distance(b.Min, orb.Point{0, 0}) > 1e-9 || Distance(b.Max, orb.Point{2, 2}) > 1e-9 {
+		t.Errorf("should cover the segment: %v", b)
+	}
+
+	rect = MinimumRotatedRect(orb.MultiPoint{{1, 2}, {1, 2}})
+	expected := orb.Polygon{{{1, 2}, {1, 2}, {1, 2}, {1, 2}, {1, 2}}}
+	if !rect.Equal(expected) {
+		t.Errorf("single point should return that point: %v", rect)
+	}
+
+	if v := MinimumRotatedRect(nil); v != nil {
+		t.Errorf("empty should return nil: %v", v)
+	}
+}
+
+func TestMinimumRotatedRect_random(t *testing.T) {
+	r := rand.New(rand.NewSource(42))
+
+	for i := 0; i < 50; i++ {
+		mp := orb.MultiPoint{}
+		for j := 0; j < 3+r.Intn(100); j++ {
+			mp = append(mp, orb.Point{100 * r.Float64(), 30 * r.Float64()})
+		}
+
+		// brute force every hull edge against every hull point
+		hull := convexHull(uniquePoints(mp))
+		expected := math.Inf(1)
+		for k := 0; k < len(hull)-1; k++ {
+			a, b := hull[k], hull[k+1]
+			l := Distance(a, b)
+			ex, ey := (b[0]-a[0])/l, (b[1]-a[1])/l
+
+			minU, maxU, maxV := math.Inf(1), math.Inf(-1), 0.0
+			for _, p := range hull {
+				u := (p[0]-a[0])*ex + (p[1]-a[1])*ey
+				v := (p[0]-a[0])*-ey + (p[1]-a[1])*ex
+				minU, maxU, maxV = math.Min(minU, u), math.Max(maxU, u), math.Max(maxV, v)
+			}
+			expected = math.Min(expected, (maxU-minU)*maxV)
+		}
+
+		rect := MinimumRotatedRect(mp)
+		if a := Area(rect); math.Abs(a-expected) > 1e-6 {
+			t.Errorf("incorrect area: %v != %v", a, expected)
+		}
+
+		for _, p := range mp {
+			if DistanceFrom(rect, p) > 1e-9 && !PolygonContains(rect, p) {
+				t.Errorf("point outside the rectangle: %v", p)
+			}
+		}
+	}
+}
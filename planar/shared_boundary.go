@@ -0,0 +1,87 @@
+package planar
+
+import (
+	"math"
+	"sort"
+
+	"github.com/paulmach/orb"
+)
+
+// SharedBoundaryLength returns the length of the boundary the two polygons
+// have in common. Parts of the edges of the first polygon are shared if an
+// edge of the second polygon runs along them, with both its endpoints within
+// the tolerance of the edge's line. The edges do not need to have the same
+// vertices. Polygons that are not adjacent return 0.
+func SharedBoundaryLength(a, b orb.Polygon, tolerance float64) float64 {
+	if len(a) == 0 || len(b) == 0 || tolerance < 0 {
+		return 0
+	}
+
+	if !a.Bound().Pad(tolerance).Intersects(b.Bound()) {
+		return 0
+	}
+
+	type interval struct{ start, end float64 }
+
+	total := 0.0
+	var overlaps []interval
+	for _, ra := range a {
+		for i := 0; i < len(ra)-1; i++ {
+			a1, a2 := ra[i], ra[i+1]
+			dx, dy := a2[0]-a1[0], a2[1]-a1[1]
+			l := math.Sqrt(dx*dx + dy*dy)
+			if l == 0 {
+				continue
+			}
+
+			// unit direction and the edge bound to quickly skip far away edges
+			ux, uy := dx/l, dy/l
+			eb := orb.MultiPoint{a1, a2}.Bound().Pad(tolerance)
+
+			overlaps = overlaps[:0]
+			for _, rb := range b {
+				for j := 0; j < len(rb)-1; j++ {
+					b1, b2 := rb[j], rb[j+1]
+					if !eb.Intersects(orb.MultiPoint{b1, b2}.Bound()) {
+						continue
+					}
+
+					// distance from the line and position along the edge
+					d1 := math.Abs((b1[0]-a1[0])*uy - (b1[1]-a1[1])*ux)
+					d2 := math.Abs((b2[0]-a1[0])*uy - (b2[1]-a1[1])*ux)
+					if d1 > tolerance || d2 > tolerance {
+						continue
+					}
+
+					s := (b1[0]-a1[0])*ux + (b1[1]-a1[1])*uy
+					e := (b2[0]-a1[0])*ux + (b2[1]-a1[1])*uy
+					if s > e {
+						s, e = e, s
+					}
+
+					s = math.Max(s, 0)
+					e = math.Min(e, l)
+					if e > s {
+						overlaps = append(overlaps, interval{start: s, end: e})
+					}
+				}
+			}
+
+			// merge the overlaps so parts are not counted twice
+			sort.Slice(overlaps, func(i, j int) bool { return overlaps[i].start < overlaps[j].start })
+
+			end := math.Inf(-1)
+			for _, o := range overlaps {
+				if o.start > end {
+					total += o.end - o.start
+					end = o.end
+				} else if o.end > end {
+					total += o.end - end
+					end = o.end
+				}
+			}
+		}
+	}
+
+	return total
+}
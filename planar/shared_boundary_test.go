@@ -0,0 +1,81 @@
+package planar
+
+import (
+	"math"
+	"testing"
+
+	"github.com/paulmach/orb"
+)
+
+func TestSharedBoundaryLength(t *testing.T) {
+	square := orb.Polygon{{{0, 0}, {2, 0}, {2, 2}, {0, 2}, {0, 0}}}
+
+	cases := []struct {
+		name      string
+		b         orb.Polygon
+		tolerance float64
+		length    float64
+	}{
+		{
+			name:   "full shared edge",
+			b:      orb.Polygon{{{2, 0}, {4, 0}, {4, 2}, {2, 2}, {2, 0}}},
+			length: 2,
+		},
+		{
+			name:   "partially shared edge",
+			b:      orb.Polygon{{{2, 1}, {4, 1}, {4, 5}, {2, 5}, {2, 1}}},
+			length: 1,
+		},
+		{
+			name:   "shared edge with extra vertices",
+			b:      orb.Polygon{{{2, 0}, {3, 0}, {3, 2}, {2, 2}, {2, 1.5}, {2, 0.5}, {2, 0}}},
+			length: 2,
+		},
+		{
+			name:   "shared on two sides",
+			b:      orb.Polygon{{{2, -1}, {3, -1}, {3, 3}, {-1, 3}, {-1, 2}, {2, 2}, {2, -1}}},
+			length: 4,
+		},
+		{
+			name:   "touching at a corner",
+			b:      orb.Polygon{{{2, 2}, {3, 2}, {3, 3}, {2, 3}, {2, 2}}},
+			length: 0,
+		},
+		{
+			name:   "disjoint",
+			b:      orb.Polygon{{{5, 5}, {6, 5}, {6, 6}, {5, 6}, {5, 5}}},
+			length: 0,
+		},
+		{
+			name:      "small gap within tolerance",
+			b:         orb.Polygon{{{2.001, 0}, {4, 0}, {4, 2}, {2.001, 2}, {2.001, 0}}},
+			tolerance: 0.01,
+			length:    2,
+		},
+		{
+			name:      "small gap outside tolerance",
+			b:         orb.Polygon{{{2.1, 0}, {4, 0}, {4, 2}, {2.1, 2}, {2.1, 0}}},
+			tolerance: 0.01,
+			length:    0,
+		},
+		{
+			name:   "shared with a hole",
+			b:      orb.Polygon{{{-1, -1}, {3, -1}, {3, 3}, {-1, 3}, {-1, -1}}, {{0, 0}, {0, 2}, {2, 2}, {2, 0}, {0, 0}}},
+			length: 8,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			l := SharedBoundaryLength(square, tc.b, tc.tolerance)
+			if math.Abs(l-tc.length) > 1e-10 {
+				t.Errorf("incorrect length: %v != %v", l, tc.length)
+			}
+
+			l = SharedBoundaryLength(tc.b, square, tc.tolerance)
+			if math.Abs(l-tc.length) > 1e-10 {
+				t.Errorf("incorrect reverse length: %v != %v", l, tc.length)
+			}
+		})
+	}
+}
@@ -0,0 +1,163 @@
+package planar
+
+import (
+	"github.com/paulmach/orb"
+)
+
+// SnapTo returns a copy of geometry a with its points moved onto geometry b
+// if they are within the tolerance of it. Points are snapped to the nearest
+// vertex of b within the tolerance, or if there are none, to the nearest point
+// on the edges of b. This can be used to close small gaps between datasets.
+// Bounds are returned unchanged. The input geometries are not modified.
+func SnapTo(a, b orb.Geometry, tolerance float64) orb.Geometry {
+	if a == nil {
+		return nil
+	}
+
+	a = orb.Clone(a)
+	if b == nil || tolerance < 0 {
+		return a
+	}
+
+	s := &vertexSnapper{tolerance: tolerance}
+	s.collect(b)
+	if p, ok := a.(orb.Point); ok {
+		return s.snap(p)
+	}
+
+	s.snapGeometry(a)
+	return a
+}
+
+type vertexSnapper struct {
+	tolerance float64
+	vertices  []orb.Point
+	segments  [][2]orb.Point
+}
+
+// collect adds the vertices and edges of the geometry to snap to.
+func (s *vertexSnapper) collect(g orb.Geometry) {
+	switch g := g.(type) {
+	case orb.Point:
+		s.vertices = append(s.vertices, g)
+	case orb.MultiPoint:
+		s.vertices = append(s.vertices, g...)
+	case orb.LineString:
+		s.collectPath(g)
+	case orb.MultiLineString:
+		for _, ls := range g {
+			s.collectPath(ls)
+		}
+	case orb.Ring:
+		s.collectPath(g)
+	case orb.Polygon:
+		for _, r := range g {
+			s.collectPath(r)
+		}
+	case orb.MultiPolygon:
+		for _, p := range g {
+			for _, r := range p {
+				s.collectPath(r)
+			}
+		}
+	case orb.Collection:
+		for _, c := range g {
+			s.collect(c)
+		}
+	case orb.Bound:
+		s.collectPath(g.ToRing())
+	}
+}
+
+func (s *vertexSnapper) collectPath(ps []orb.Point) {
+	s.vertices = append(s.vertices, ps...)
+	for i := 0; i < len(ps)-1; i++ {
+		s.segments = append(s.segments, [2]orb.Point{ps[i], ps[i+1]})
+	}
+}
+
+// snapGeometry snaps the points of the geometry in place.
+func (s *vertexSnapper) snapGeometry(g orb.Geometry) {
+	switch g := g.(type) {
+	case orb.MultiPoint:
+		s.snapPoints(g)
+	case orb.LineString:
+		s.snapPoints(g)
+	case orb.MultiLineString:
+		for _, ls := range g {
+			s.snapPoints(ls)
+		}
+	case orb.Ring:
+		s.snapPoints(g)
+	case orb.Polygon:
+		for _, r := range g {
+			s.snapPoints(r)
+		}
+	case orb.MultiPolygon:
+		for _, p := range g {
+			for _, r := range p {
+				s.snapPoints(r)
+			}
+		}
+	case orb.Collection:
+		for i := range g {
+			if p, ok := g[i].(orb.Point); ok {
+				g[i] = s.snap(p)
+			} else {
+				s.snapGeometry(g[i])
+			}
+		}
+	}
+}
+
+func (s *vertexSnapper) snapPoints(ps []orb.Point) {
+	for i := range ps {
+		ps[i] = s.snap(ps[i])
+	}
+}
+
+func (s *vertexSnapper) snap(p orb.Point) orb.Point {
+	limit := s.tolerance * s.tolerance
+
+	result, best := p, limit
+	found := false
+	for _, v := range s.vertices {
+		if d := DistanceSquared(p, v); d <= best {
+			result, best = v, d
+			found = true
+		}
+	}
+
+	if found {
+		return result
+	}
+
+	best = limit
+	for _, seg := range s.segments {
+		c := closestOnSegment(seg[0], seg[1], p)
+		if d := DistanceSquared(p, c); d <= best {
+			result, best = c, d
+		}
+	}
+
+	return result
+}
+
+// closestOnSegment returns the point on the segment [a, b] nearest to p.
+func closestOnSegment(a, b, p orb.Point) orb.Point {
+	dx, dy := b[0]-a[0], b[1]-a[1]
+	if dx == 0 && dy == 0 {
+		return a
+	}
+
+	t := ((p[0]-a[0])*dx + (p[1]-a[1])*dy) / (dx*dx + dy*dy)
+	if t <= 0 {
+		return a
+	}
+
+	if t >= 1 {
+		return b
+	}
+
+	return orb.Point{a[0] + t*dx, a[1] + t*dy}
+}
@@ -0,0 +1,88 @@
+package planar
+
+import (
+	"testing"
+
+	"github.com/paulmach/orb"
+)
+
+func TestSnapTo(t *testing.T) {
+	reference := orb.LineString{{0, 0}, {10, 0}, {10, 10}}
+
+	cases := []struct {
+		name     string
+		geom     orb.Geometry
+		expected orb.Geometry
+	}{
+		{
+			name:     "point to vertex",
+			geom:     orb.Point{10.05, 0.05},
+			expected: orb.Point{10, 0},
+		},
+		{
+			name:     "point to edge",
+			geom:     orb.Point{5, 0.05},
+			expected: orb.Point{5, 0},
+		},
+		{
+			name:     "vertex preferred over nearer edge",
+			geom:     orb.Point{0.09, 0.01},
+			expected: orb.Point{0, 0},
+		},
+		{
+			name:     "point too far",
+			geom:     orb.Point{5, 1},
+			expected: orb.Point{5, 1},
+		},
+		{
+			name:     "line string",
+			geom:     orb.LineString{{-0.05, 0.05}, {5, -0.1}, {5, -5}, {9.95, 5}},
+			expected: orb.LineString{{0, 0}, {5, 0}, {5, -5}, {10, 5}},
+		},
+		{
+			name:     "polygon",
+			geom:     orb.Polygon{{{0, 0.1}, {5, 0.1}, {5, 5}, {0, 5}, {0, 0.1}}},
+			expected: orb.Polygon{{{0, 0}, {5, 0}, {5, 5}, {0, 5}, {0, 0}}},
+		},
+		{
+			name:     "collection",
+			geom:     orb.Collection{orb.Point{10, 9.9}, orb.MultiPoint{{3, 0.1}}},
+			expected: orb.Collection{orb.Point{10, 10}, orb.MultiPoint{{3, 0}}},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			original := orb.Clone(tc.geom)
+
+			result := SnapTo(tc.geom, reference, 0.1+1e-9)
+			if !orb.Equal(result, tc.expected) {
+				t.Errorf("incorrect result: %v != %v", result, tc.expected)
+			}
+
+			if !orb.Equal(tc.geom, original) {
+				t.Errorf("should not modify the input")
+			}
+		})
+	}
+}
+
+func TestSnapTo_polygonReference(t *testing.T) {
+	reference := orb.Polygon{{{0, 0}, {10, 0}, {10, 10}, {0, 10}, {0, 0}}}
+
+	ls := orb.LineString{{-0.01, 5}, {10.01, 5}}
+	result := SnapTo(ls, reference, 0.1)
+
+	expected := orb.LineString{{0, 5}, {10, 5}}
+	if !orb.Equal(result, expected) {
+		t.Errorf("incorrect result: %v != %v", result, expected)
+	}
+
+	if v := SnapTo(nil, reference, 1); v != nil {
+		t.Errorf("nil should return nil: %v", v)
+	}
+
+	if v := SnapTo(ls, nil, 1); !orb.Equal(v, ls) {
+		t.Errorf("nil reference should return a copy: %v", v)
+	}
+}
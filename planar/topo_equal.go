@@ -0,0 +1,239 @@
+package planar
+
+import (
+	"github.com/paulmach/orb"
+)
+
+// TopoEqual returns true if the geometries cover the same points in the plane,
+// within the tolerance, even if their vertices differ. Repeated and collinear
+// vertices are ignored, rings can start at any vertex and have either direction,
+// and line strings can be reversed. The parts of multi-geometries and
+// collections, and the holes of polygons, can be in any order. Rings and bounds
+// are compared as polygons.
+func TopoEqual(a, b orb.Geometry, tolerance float64) bool {
+	if a == nil || b == nil {
+		return a == nil && b == nil
+	}
+
+	a, b = topoNormalize(a), topoNormalize(b)
+
+	switch a := a.(type) {
+	case orb.Point:
+		b, ok := b.(orb.Point)
+		return ok && topoPointEqual(a, b, tolerance)
+	case orb.MultiPoint:
+		b, ok := b.(orb.MultiPoint)
+		if !ok {
+			return false
+		}
+
+		// every point must have a match in the other, there can be duplicates.
+		return topoCovers(a, b, tolerance) && topoCovers(b, a, tolerance)
+	case orb.LineString:
+		b, ok := b.(orb.LineString)
+		return ok && topoLineStringEqual(a, b, tolerance)
+	case orb.MultiLineString:
+		b, ok := b.(orb.MultiLineString)
+		if !ok || len(a) != len(b) {
+			return false
+		}
+
+		return topoMatch(len(a), func(i, j int) bool {
+			return topoLineStringEqual(a[i], b[j], tolerance)
+		})
+	case orb.Polygon:
+		b, ok := b.(orb.Polygon)
+		return ok && topoPolygonEqual(a, b, tolerance)
+	case orb.MultiPolygon:
+		b, ok := b.(orb.MultiPolygon)
+		if !ok || len(a) != len(b) {
+			return false
+		}
+
+		return topoMatch(len(a), func(i, j int) bool {
+			return topoPolygonEqual(a[i], b[j], tolerance)
+		})
+	case orb.Collection:
+		b, ok := b.(orb.Collection)
+		if !ok || len(a) != len(b) {
+			return false
+		}
+
+		return topoMatch(len(a), func(i, j int) bool {
+			return TopoEqual(a[i], b[j], tolerance)
+		})
+	}
+
+	return false
+}
+
+// topoNormalize converts the rings and bounds into polygons
+// so they can be compared with each other.
+func topoNormalize(g orb.Geometry) orb.Geometry {
+	switch g := g.(type) {
+	case orb.Bound:
+		return g.ToPolygon()
+	case orb.Ring:
+		return orb.Polygon{g}
+	}
+
+	return g
+}
+
+func topoPointEqual(a, b orb.Point, tolerance float64) bool {
+	return DistanceSquared(a, b) <= tolerance*tolerance
+}
+
+// topoCovers returns true if every point in a is near a point in b.
+func topoCovers(a, b []orb.Point, tolerance float64) bool {
+	for _, p := range a {
+		found := false
+		for _, q := range b {
+			if topoPointEqual(p, q, tolerance) {
+				found = true
+				break
+			}
+		}
+
+		if !found {
+			return false
+		}
+	}
+
+	return true
+}
+
+// topoMatch returns true if every one of the n items can be paired with
+// a different item of the other geometry using the equal function.
+func topoMatch(n int, equal func(i, j int) bool) bool {
+	used := make([]bool, n)
+	for i := 0; i < n; i++ {
+		found := false
+		for j := 0; j < n; j++ {
+			if !used[j] && equal(i, j) {
+				used[j] = true
+				found = true
+				break
+			}
+		}
+
+		if !found {
+			return false
+		}
+	}
+
+	return true
+}
+
+func topoLineStringEqual(a, b orb.LineString, tolerance float64) bool {
+	a = topoSimplify(a, tolerance)
+	b = topoSimplify(b, tolerance)
+	if len(a) != len(b) {
+		return false
+	}
+
+	forward, backward := true, true
+	for i := range a {
+		forward = forward && topoPointEqual(a[i], b[i], tolerance)
+		backward = backward && topoPointEqual(a[i], b[len(b)-1-i], tolerance)
+	}
+
+	return forward || backward
+}
+
+func topoPolygonEqual(a, b orb.Polygon, tolerance float64) bool {
+	if len(a) != len(b) {
+		return false
+	}
+
+	if len(a) == 0 {
+		return true
+	}
+
+	if !topoRingEqual(a[0], b[0], tolerance) {
+		return false
+	}
+
+	holesA, holesB := a[1:], b[1:]
+	return topoMatch(len(holesA), func(i, j int) bool {
+		return topoRingEqual(holesA[i], holesB[j], tolerance)
+	})
+}
+
+func topoRingEqual(a, b orb.Ring, tolerance float64) bool {
+	pa := topoSimplifyRing(a, tolerance)
+	pb := topoSimplifyRing(b, tolerance)
+	if len(pa) != len(pb) {
+		return false
+	}
+
+	n := len(pa)
+	if n == 0 {
+		return true
+	}
+
+	// try every start and both directions
+	for start := 0; start < n; start++ {
+		if !topoPointEqual(pa[0], pb[start], tolerance) {
+			continue
+		}
+
+		forward, backward := true, true
+		for i := 0; i < n && (forward || backward); i++ {
+			forward = forward && topoPointEqual(pa[i], pb[(start+i)%n], tolerance)
+			backward = backward && topoPointEqual(pa[i], pb[(start-i+n)%n], tolerance)
+		}
+
+		if forward || backward {
+			return true
+		}
+	}
+
+	return false
+}
+
+// topoSimplify returns a new line string without repeated points and
+// without points within the tolerance of the segment between their neighbors.
+func topoSimplify(ls orb.LineString, tolerance float64) orb.LineString {
+	result := make(orb.LineString, 0, len(ls))
+	for _, p := range ls {
+		if len(result) > 0 && topoPointEqual(result[len(result)-1], p, tolerance) {
+			continue
+		}
+
+		for len(result) >= 2 && topoCollinear(result[len(result)-2], result[len(result)-1], p, tolerance) {
+			result = result[:len(result)-1]
+		}
+
+		result = append(result, p)
+	}
+
+	return result
+}
+
+// topoSimplifyRing returns the simplified ring without the closing point.
+// Points collinear across the start of the ring are also removed.
+func topoSimplifyRing(r orb.Ring, tolerance float64) []orb.Point {
+	ps := topoSimplify(orb.LineString(r), tolerance)
+	for len(ps) > 1 && topoPointEqual(ps[0], ps[len(ps)-1], tolerance) {
+		ps = ps[:len(ps)-1]
+	}
+
+	for len(ps) > 2 {
+		n := len(ps)
+		if topoCollinear(ps[n-1], ps[0], ps[1], tolerance) {
+			ps = ps[1:]
+		} else if topoCollinear(ps[n-2], ps[n-1], ps[0], tolerance) {
+			ps = ps[:n-1]
+		} else {
+			break
+		}
+	}
+
+	return ps
+}
+
+// topoCollinear returns true if b is within the tolerance of the segment a-c.
+func topoCollinear(a, b, c orb.Point, tolerance float64) bool {
+	return DistanceFromSegmentSquared(a, c, b) <= tolerance*tolerance
+}
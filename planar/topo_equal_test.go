@@ -0,0 +1,137 @@
+package planar
+
+import (
+	"testing"
+
+	"github.com/paulmach/orb"
+)
+
+func TestTopoEqual(t *testing.T) {
+	square := orb.Polygon{{{0, 0}, {2, 0}, {2, 2}, {0, 2}, {0, 0}}}
+
+	cases := []struct {
+		name      string
+		a, b      orb.Geometry
+		tolerance float64
+		equal     bool
+	}{
+		{
+			name:  "same polygon",
+			a:     square,
+			b:     square,
+			equal: true,
+		},
+		{
+			name:  "extra collinear vertices",
+			a:     square,
+			b:     orb.Polygon{{{0, 0}, {1, 0}, {2, 0}, {2, 1}, {2, 2}, {0, 2}, {0, 1}, {0, 0}}},
+			equal: true,
+		},
+		{
+			name:  "collinear vertex at the start",
+			a:     square,
+			b:     orb.Polygon{{{1, 0}, {2, 0}, {2, 2}, {0, 2}, {0, 0}, {1, 0}}},
+			equal: true,
+		},
+		{
+			name:  "rotated and reversed",
+			a:     square,
+			b:     orb.Polygon{{{2, 2}, {2, 0}, {0, 0}, {0, 2}, {2, 2}}},
+			equal: true,
+		},
+		{
+			name:  "repeated vertices",
+			a:     square,
+			b:     orb.Polygon{{{0, 0}, {0, 0}, {2, 0}, {2, 2}, {2, 2}, {0, 2}, {0, 0}}},
+			equal: true,
+		},
+		{
+			name:  "different polygon",
+			a:     square,
+			b:     orb.Polygon{{{0, 0}, {2, 0}, {2, 3}, {0, 2}, {0, 0}}},
+			equal: false,
+		},
+		{
+			name:      "within tolerance",
+			a:         square,
+			b:         orb.Polygon{{{0, 0}, {1, 0.001}, {2, 0}, {2, 2}, {0, 2.001}, {0, 0}}},
+			tolerance: 0.01,
+			equal:     true,
+		},
+		{
+			name:      "outside tolerance",
+			a:         square,
+			b:         orb.Polygon{{{0, 0}, {1, 0.1}, {2, 0}, {2, 2}, {0, 2}, {0, 0}}},
+			tolerance: 0.01,
+			equal:     false,
+		},
+		{
+			name:  "bound and ring",
+			a:     orb.Bound{Min: orb.Point{0, 0}, Max: orb.Point{2, 2}},
+			b:     orb.Ring{{0, 2}, {0, 0}, {1, 0}, {2, 0}, {2, 2}, {0, 2}},
+			equal: true,
+		},
+		{
+			name: "holes in any order",
+			a: orb.Polygon{
+				{{0, 0}, {10, 0}, {10, 10}, {0, 10}, {0, 0}},
+				{{1, 1}, {1, 2}, {2, 2}, {2, 1}, {1, 1}},
+				{{5, 5}, {5, 6}, {6, 6}, {6, 5}, {5, 5}},
+			},
+			b: orb.Polygon{
+				{{0, 0}, {10, 0}, {10, 10}, {0, 10}, {0, 0}},
+				{{5, 5}, {5, 6}, {6, 6}, {6, 5}, {5, 5}},
+				{{2, 2}, {2, 1}, {1, 1}, {1, 2}, {2, 2}},
+			},
+			equal: true,
+		},
+		{
+			name:  "reversed line string",
+			a:     orb.LineString{{0, 0}, {1, 1}, {2, 2}, {3, 0}},
+			b:     orb.LineString{{3, 0}, {2, 2}, {0, 0}},
+			equal: true,
+		},
+		{
+			name:  "line string with spike",
+			a:     orb.LineString{{0, 0}, {2, 0}},
+			b:     orb.LineString{{0, 0}, {3, 0}, {2, 0}},
+			equal: false,
+		},
+		{
+			name:  "multi polygon in any order",
+			a:     orb.MultiPolygon{square, {{{5, 5}, {6, 5}, {6, 6}, {5, 5}}}},
+			b:     orb.MultiPolygon{{{{6, 6}, {5, 5}, {6, 5}, {6, 6}}}, square},
+			equal: true,
+		},
+		{
+			name:  "multi point with duplicates",
+			a:     orb.MultiPoint{{0, 0}, {1, 1}, {1, 1}},
+			b:     orb.MultiPoint{{1, 1}, {0, 0}},
+			equal: true,
+		},
+		{
+			name:  "collection",
+			a:     orb.Collection{orb.Point{1, 2}, square},
+			b:     orb.Collection{orb.Ring(square[0]), orb.Point{1, 2}},
+			equal: true,
+		},
+		{
+			name:  "different types",
+			a:     orb.Point{0, 0},
+			b:     orb.MultiPoint{{0, 0}},
+			equal: false,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			if v := TopoEqual(tc.a, tc.b, tc.tolerance); v != tc.equal {
+				t.Errorf("incorrect result: %v != %v", v, tc.equal)
+			}
+
+			if v := TopoEqual(tc.b, tc.a, tc.tolerance); v != tc.equal {
+				t.Errorf("incorrect reverse result: %v != %v", v, tc.equal)
+			}
+		})
+	}
+}
@@ -0,0 +1,116 @@
+package planar
+
+import (
+	"github.com/paulmach/orb"
+)
+
+// Triangulate decomposes the polygon into triangles using ear clipping.
+// Only the outer ring is considered, holes are ignored. The ring is expected
+// to be simple, ie. not self-intersecting. The triangles are returned in
+// counter-clockwise order. Polygons with an outer ring of less than 3
+// distinct points return nil. Non-simple rings can run out of ears before
+// the whole ring is consumed, nil is returned for these instead of a partial
+// set of triangles.
+func Triangulate(p orb.Polygon) [][3]orb.Point {
+	if len(p) == 0 {
+		return nil
+	}
+
+	r := p[0]
+	if len(r) > 1 && r[0] == r[len(r)-1] {
+		r = r[:len(r)-1]
+	}
+
+	if len(r) < 3 {
+		return nil
+	}
+
+	// indexes of the remaining vertices, in counter-clockwise order.
+	indexes := make([]int, len(r))
+	if ringSignedArea(r) < 0 {
+		for i := range indexes {
+			indexes[i] = len(r) - 1 - i
+		}
+	} else {
+		for i := range indexes {
+			indexes[i] = i
+		}
+	}
+
+	result := make([][3]orb.Point, 0, len(r)-2)
+
+	// misses counts the vertices checked since the last ear was removed.
+	// If all the remaining vertices are checked without finding an ear
+	// the ring is not simple and we stop.
+	i, misses := 0, 0
+	for len(indexes) > 2 && misses < len(indexes) {
+		prev := r[indexes[(i+len(indexes)-1)%len(indexes)]]
+		curr := r[indexes[i]]
+		next := r[indexes[(i+1)%len(indexes)]]
+
+		c := cross(prev, curr, next)
+		if c == 0 {
+			// collinear or duplicate vertex, remove it without a triangle.
+			indexes = append(indexes[:i], indexes[i+1:]...)
+			i %= len(indexes)
+			misses = 0
+			continue
+		}
+
+		if c > 0 && isEar(r, indexes, prev, curr, next) {
+			result = append(result, [3]orb.Point{prev, curr, next})
+			indexes = append(indexes[:i], indexes[i+1:]...)
+			i %= len(indexes)
+			misses = 0
+			continue
+		}
+
+		i = (i + 1) % len(indexes)
+		misses++
+	}
+
+	if len(indexes) > 2 {
+		// ran out of ears, the ring is not simple.
+		return nil
+	}
+
+	if len(result) == 0 {
+		return nil
+	}
+
+	return result
+}
+
+// isEar checks that no other remaining vertex is within the triangle.
+func isEar(r orb.Ring, indexes []int, a, b, c orb.Point) bool {
+	for _, i := range indexes {
+		p := r[i]
+		if p == a || p == b || p == c {
+			continue
+		}
+
+		if cross(a, b, p) >= 0 && cross(b, c, p) >= 0 && cross(c, a, p) >= 0 {
+			return false
+		}
+	}
+
+	return true
+}
+
+// cross returns the z component of the cross product of the vectors
+// a->b and b->c. Positive if a, b, c make a counter-clockwise turn.
+func cross(a, b, c orb.Point) float64 {
+	return (b[0]-a[0])*(c[1]-b[1]) - (b[1]-a[1])*(c[0]-b[0])
+}
+
+// ringSignedArea returns twice the signed area of the ring,
+// the ring does not need to be closed.
+func ringSignedArea(r orb.Ring) float64 {
+	area := 0.0
+	for i := range r {
+		j := (i + 1) % len(r)
+		area += r[i][0]*r[j][1] - r[j][0]*r[i][1]
+	}
+
+	return area
+}
@@ -0,0 +1,89 @@
+package planar
+
+import (
+	"math"
+	"testing"
+
+	"github.com/paulmach/orb"
+)
+
+func TestTriangulate(t *testing.T) {
+	cases := []struct {
+		name  string
+		poly  orb.Polygon
+		count int
+	}{
+		{
+			name:  "triangle",
+			poly:  orb.Polygon{{{0, 0}, {1, 0}, {0, 1}, {0, 0}}},
+			count: 1,
+		},
+		{
+			name:  "square",
+			poly:  orb.Polygon{{{0, 0}, {1, 0}, {1, 1}, {0, 1}, {0, 0}}},
+			count: 2,
+		},
+		{
+			name:  "clockwise square",
+			poly:  orb.Polygon{{{0, 0}, {0, 1}, {1, 1}, {1, 0}, {0, 0}}},
+			count: 2,
+		},
+		{
+			name:  "not closed",
+			poly:  orb.Polygon{{{0, 0}, {1, 0}, {1, 1}, {0, 1}}},
+			count: 2,
+		},
+		{
+			name:  "concave",
+			poly:  orb.Polygon{{{0, 0}, {4, 0}, {4, 4}, {2, 1}, {0, 4}, {0, 0}}},
+			count: 3,
+		},
+		{
+			name:  "collinear point",
+			poly:  orb.Polygon{{{0, 0}, {1, 0}, {2, 0}, {2, 2}, {0, 2}, {0, 0}}},
+			count: 3,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			triangles := Triangulate(tc.poly)
+			if len(triangles) != tc.count {
+				t.Fatalf("incorrect number of triangles: %d != %d", len(triangles), tc.count)
+			}
+
+			area := 0.0
+			for _, tri := range triangles {
+				a := Area(orb.Ring{tri[0], tri[1], tri[2], tri[0]})
+				if a <= 0 {
+					t.Errorf("triangle should be counter-clockwise: %v", tri)
+				}
+				area += a
+			}
+
+			if expected := Area(tc.poly); math.Abs(area-expected) > 1e-10 {
+				t.Errorf("incorrect total area: %v != %v", area, expected)
+			}
+		})
+	}
+}
+
+func TestTriangulate_empty(t *testing.T) {
+	cases := []orb.Polygon{
+		nil,
+		{},
+		{{}},
+		{{{0, 0}, {1, 1}, {0, 0}}},
+		{{{0, 0}, {1, 1}, {2, 2}, {0, 0}}},
+
+		// self-intersecting, runs out of ears
+		{{{0, 0}, {2, 2}, {2, 0}, {0, 2}, {0, 0}}},
+		{{{0, 0}, {6, 0}, {6, 6}, {-2, 2}, {8, 2}, {0, 6}, {0, 0}}},
+	}
+
+	for i, p := range cases {
+		if v := Triangulate(p); v != nil {
+			t.Errorf("%d: should return nil: %v", i, v)
+		}
+	}
+}
@@ -0,0 +1,157 @@
+package planar
+
+import (
+	"fmt"
+
+	"github.com/paulmach/orb"
+)
+
+// Within returns true if the geometry lies completely inside the polygon.
+// All the vertices of the geometry must be inside the polygon and no edge
+// may cross the boundary of the polygon. Points on the boundary are considered in.
+// Nil and empty geometries are not within anything.
+func Within(inner orb.Geometry, outer orb.Polygon) bool {
+	if inner == nil || len(outer) == 0 {
+		return false
+	}
+
+	switch g := inner.(type) {
+	case orb.Point:
+		return PolygonContains(outer, g)
+	case orb.MultiPoint:
+		if len(g) == 0 {
+			return false
+		}
+
+		for _, p := range g {
+			if !PolygonContains(outer, p) {
+				return false
+			}
+		}
+
+		return true
+	case orb.LineString:
+		return lineStringWithin(g, outer)
+	case orb.MultiLineString:
+		if len(g) == 0 {
+			return false
+		}
+
+		for _, ls := range g {
+			if !lineStringWithin(ls, outer) {
+				return false
+			}
+		}
+
+		return true
+	case orb.Ring:
+		return polygonWithin(orb.Polygon{g}, outer)
+	case orb.Polygon:
+		return polygonWithin(g, outer)
+	case orb.MultiPolygon:
+		if len(g) == 0 {
+			return false
+		}
+
+		for _, p := range g {
+			if !polygonWithin(p, outer) {
+				return false
+			}
+		}
+
+		return true
+	case orb.Collection:
+		if len(g) == 0 {
+			return false
+		}
+
+		for _, c := range g {
+			if !Within(c, outer) {
+				return false
+			}
+		}
+
+		return true
+	case orb.Bound:
+		return polygonWithin(g.ToPolygon(), outer)
+	}
+
+	panic(fmt.Sprintf("geometry type not supported: %T", inner))
+}
+
+func lineStringWithin(ls orb.LineString, outer orb.Polygon) bool {
+	if len(ls) == 0 {
+		return false
+	}
+
+	for _, p := range ls {
+		if !PolygonContains(outer, p) {
+			return false
+		}
+	}
+
+	for i := 0; i < len(ls)-1; i++ {
+		// the midpoint catches segments that go outside and back in
+		// through a vertex of the boundary without a proper crossing.
+		mid := orb.Point{(ls[i][0] + ls[i+1][0]) / 2, (ls[i][1] + ls[i+1][1]) / 2}
+		if !PolygonContains(outer, mid) {
+			return false
+		}
+
+		for _, r := range outer {
+			for j := 0; j < len(r)-1; j++ {
+				if segmentsCross(ls[i], ls[i+1], r[j], r[j+1]) {
+					return false
+				}
+			}
+		}
+	}
+
+	return true
+}
+
+func polygonWithin(p orb.Polygon, outer orb.Polygon) bool {
+	if len(p) == 0 {
+		return false
+	}
+
+	for _, r := range p {
+		if !lineStringWithin(orb.LineString(r), outer) {
+			return false
+		}
+	}
+
+	// a hole of the outer polygon could be inside the area of the polygon.
+	for _, h := range outer[1:] {
+		for _, v := range h {
+			if PolygonContains(p, v) && !ringBoundaryContains(p[0], v) {
+				return false
+			}
+		}
+	}
+
+	return true
+}
+
+// ringBoundaryContains returns true if the point is on the boundary of the ring.
+func ringBoundaryContains(r orb.Ring, point orb.Point) bool {
+	for i := 0; i < len(r)-1; i++ {
+		if _, on := rayIntersect(point, r[i], r[i+1]); on {
+			return true
+		}
+	}
+
+	return false
+}
+
+// segmentsCross returns true if the two segments properly intersect,
+// ie. they cross at a point interior to both. Touching is not crossing.
+func segmentsCross(a1, a2, b1, b2 orb.Point) bool {
+	d1 := cross(b1, b2, a1)
+	d2 := cross(b1, b2, a2)
+	d3 := cross(a1, a2, b1)
+	d4 := cross(a1, a2, b2)
+
+	return ((d1 > 0 && d2 < 0) || (d1 < 0 && d2 > 0)) &&
+		((d3 > 0 && d4 < 0) || (d3 < 0 && d4 > 0))
+}
@@ -0,0 +1,116 @@
+package planar
+
+import (
+	"testing"
+
+	"github.com/paulmach/orb"
+)
+
+func TestWithin(t *testing.T) {
+	for _, g := range orb.AllGeometries {
+		Within(g, orb.Polygon{{{0, 0}, {1, 0}, {1, 1}, {0, 0}}})
+	}
+
+	// +-+ +-+
+	// | | | |
+	// | +-+ |
+	// |     |
+	// +-----+
+	outer := orb.Polygon{{
+		{0, 0}, {0, 1}, {1, 1}, {1, 0.5}, {2, 0.5},
+		{2, 1}, {3, 1}, {3, 0}, {0, 0},
+	}}
+
+	withHole := orb.Polygon{
+		{{0, 0}, {4, 0}, {4, 4}, {0, 4}, {0, 0}},
+		{{1, 1}, {1, 2}, {2, 2}, {2, 1}, {1, 1}},
+	}
+
+	cases := []struct {
+		name   string
+		inner  orb.Geometry
+		outer  orb.Polygon
+		result bool
+	}{
+		{
+			name:   "point inside",
+			inner:  orb.Point{0.5, 0.5},
+			outer:  outer,
+			result: true,
+		},
+		{
+			name:   "point outside",
+			inner:  orb.Point{1.5, 0.75},
+			outer:  outer,
+			result: false,
+		},
+		{
+			name:   "line in base",
+			inner:  orb.LineString{{0.5, 0.25}, {2.5, 0.25}},
+			outer:  outer,
+			result: true,
+		},
+		{
+			name:   "line crossing between towers",
+			inner:  orb.LineString{{0.5, 0.75}, {2.5, 0.75}},
+			outer:  outer,
+			result: false,
+		},
+		{
+			name:   "line along boundary",
+			inner:  orb.LineString{{0, 0}, {3, 0}},
+			outer:  outer,
+			result: true,
+		},
+		{
+			name:   "line through hole",
+			inner:  orb.LineString{{0.5, 1.5}, {3.5, 1.5}},
+			outer:  withHole,
+			result: false,
+		},
+		{
+			name:   "line beside hole",
+			inner:  orb.LineString{{0.5, 0.5}, {3.5, 0.5}},
+			outer:  withHole,
+			result: true,
+		},
+		{
+			name:   "polygon inside",
+			inner:  orb.Polygon{{{2.5, 2.5}, {3.5, 2.5}, {3.5, 3.5}, {2.5, 2.5}}},
+			outer:  withHole,
+			result: true,
+		},
+		{
+			name:   "polygon around hole",
+			inner:  orb.Polygon{{{0.5, 0.5}, {3.5, 0.5}, {3.5, 3.5}, {0.5, 3.5}, {0.5, 0.5}}},
+			outer:  withHole,
+			result: false,
+		},
+		{
+			name:   "bound inside",
+			inner:  orb.Bound{Min: orb.Point{0.25, 0.25}, Max: orb.Point{0.75, 0.75}},
+			outer:  outer,
+			result: true,
+		},
+		{
+			name:   "multi point partly outside",
+			inner:  orb.MultiPoint{{0.5, 0.5}, {5, 5}},
+			outer:  outer,
+			result: false,
+		},
+		{
+			name:   "empty line",
+			inner:  orb.LineString{},
+			outer:  outer,
+			result: false,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			if v := Within(tc.inner, tc.outer); v != tc.result {
+				t.Errorf("incorrect result: %v != %v", v, tc.result)
+			}
+		})
+	}
+}
@@ -1,10 +1,92 @@
 package orb
 
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
 // A Point is a Lon/Lat 2d point.
 type Point [2]float64
 
 var _ Pointer = Point{}
 
+// ParsePoint parses a point from a "lon,lat" or "lon lat" string.
+// Whitespace around the coordinates is ignored.
+func ParsePoint(s string) (Point, error) {
+	parts := strings.Split(s, ",")
+	if len(parts) == 1 {
+		parts = strings.Fields(s)
+	}
+
+	p, ok := parseCoords(parts)
+	if !ok {
+		return Point{}, fmt.Errorf("orb: invalid point: %q", s)
+	}
+
+	return p, nil
+}
+
+// ReadPoints reads lines of "lon<sep>lat" from the reader, for example
+// CSV or TSV data, and returns the points. Blank lines are skipped and
+// whitespace around the coordinates is ignored. If sep is whitespace any
+// run of whitespace separates the coordinates. Errors include
+// the line number of the invalid line.
+func ReadPoints(r io.Reader, sep rune) ([]Point, error) {
+	var result []Point
+
+	scanner := bufio.NewScanner(r)
+	for line := 1; scanner.Scan(); line++ {
+		text := strings.TrimSpace(scanner.Text())
+		if text == "" {
+			continue
+		}
+
+		var parts []string
+		if unicode.IsSpace(sep) {
+			parts = strings.Fields(text)
+		} else {
+			parts = strings.Split(text, string(sep))
+		}
+
+		p, ok := parseCoords(parts)
+		if !ok {
+			return nil, fmt.Errorf("orb: line %d: invalid point: %q", line, text)
+		}
+
+		result = append(result, p)
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// parseCoords parses the lon and lat from the two parts,
+// returns false if they are not two valid numbers.
+func parseCoords(parts []string) (Point, bool) {
+	if len(parts) != 2 {
+		return Point{}, false
+	}
+
+	lon, err := strconv.ParseFloat(strings.TrimSpace(parts[0]), 64)
+	if err != nil {
+		return Point{}, false
+	}
+
+	lat, err := strconv.ParseFloat(strings.TrimSpace(parts[1]), 64)
+	if err != nil {
+		return Point{}, false
+	}
+
+	return Point{lon, lat}, true
+}
+
 // GeoJSONType returns the GeoJSON type for the object.
 func (p Point) GeoJSONType() string {
 	return "Point"
@@ -1,6 +1,7 @@
 package orb
 
 import (
+	"strings"
 	"testing"
 )
 
@@ -33,3 +34,83 @@ func TestPointEqual(t *testing.T) {
 		t.Errorf("expected: %v != %v", p3, p4)
 	}
 }
+
+func TestParsePoint(t *testing.T) {
+	cases := []struct {
+		name     string
+		input    string
+		expected Point
+	}{
+		{name: "comma", input: "1,2", expected: Point{1, 2}},
+		{name: "space", input: "1 2", expected: Point{1, 2}},
+		{name: "whitespace", input: "  -122.5 ,\t37.75 ", expected: Point{-122.5, 37.75}},
+		{name: "multiple spaces", input: "-1.5   -2.25", expected: Point{-1.5, -2.25}},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			p, err := ParsePoint(tc.input)
+			if err != nil {
+				t.Fatalf("parse error: %v", err)
+			}
+
+			if !p.Equal(tc.expected) {
+				t.Errorf("incorrect point: %v != %v", p, tc.expected)
+			}
+		})
+	}
+
+	errors := []string{"", "1", "1,2,3", "1 2 3", "a,b", "1,", ",2", "1,,2"}
+	for _, s := range errors {
+		if _, err := ParsePoint(s); err == nil {
+			t.Errorf("should return error for %q", s)
+		}
+	}
+}
+
+func TestReadPoints(t *testing.T) {
+	points, err := ReadPoints(strings.NewReader("1,2\n\n -3.5 , 4.25\r\n5,6"), ',')
+	if err != nil {
+		t.Fatalf("read error: %v", err)
+	}
+
+	expected := MultiPoint{{1, 2}, {-3.5, 4.25}, {5, 6}}
+	if !MultiPoint(points).Equal(expected) {
+		t.Errorf("incorrect points: %v != %v", points, expected)
+	}
+
+	points, err = ReadPoints(strings.NewReader("1\t2\n3\t4\n"), '\t')
+	if err != nil {
+		t.Fatalf("read error: %v", err)
+	}
+
+	expected = MultiPoint{{1, 2}, {3, 4}}
+	if !MultiPoint(points).Equal(expected) {
+		t.Errorf("incorrect points: %v != %v", points, expected)
+	}
+
+	// repeated whitespace separators
+	points, err = ReadPoints(strings.NewReader("1  2\n3 \t 4\n"), ' ')
+	if err != nil {
+		t.Fatalf("read error: %v", err)
+	}
+
+	if !MultiPoint(points).Equal(expected) {
+		t.Errorf("incorrect points: %v != %v", points, expected)
+	}
+
+	_, err = ReadPoints(strings.NewReader("1 2 3"), ' ')
+	if err == nil {
+		t.Errorf("should return error for too many fields")
+	}
+
+	_, err = ReadPoints(strings.NewReader("1,2\n\n3,a\n"), ',')
+	if err == nil || !strings.Contains(err.Error(), "line 3") {
+		t.Errorf("should return error with line number: %v", err)
+	}
+
+	_, err = ReadPoints(strings.NewReader("1,2,3"), ',')
+	if err == nil {
+		t.Errorf("should return error for too many fields")
+	}
+}
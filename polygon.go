@@ -1,5 +1,7 @@
 package orb
 
+import "math"
+
 // Polygon is a closed area. The first LineString is the outer ring.
 // The others are the holes. Each LineString is expected to be closed
 // ie. the first point matches the last.
@@ -15,6 +17,149 @@ func (p Polygon) Dimensions() int {
 	return 2
 }
 
+// Exterior returns the outer ring of the polygon, or nil if the polygon is empty.
+func (p Polygon) Exterior() Ring {
+	if len(p) == 0 {
+		return nil
+	}
+
+	return p[0]
+}
+
+// Holes returns the inner rings of the polygon, or nil if there are none.
+// The result shares the underlying array with the polygon.
+func (p Polygon) Holes() []Ring {
+	if len(p) <= 1 {
+		return nil
+	}
+
+	return p[1:]
+}
+
+// HolesAsPolygons returns each hole of the polygon as a separate polygon
+// with a single ring. The rings are copies, reversed if needed to have the
+// counter-clockwise winding of an outer ring. The outer ring is not included.
+// Returns nil if there are no holes.
+func (p Polygon) HolesAsPolygons() []Polygon {
+	if len(p) <= 1 {
+		return nil
+	}
+
+	result := make([]Polygon, 0, len(p)-1)
+	for _, h := range p[1:] {
+		hole := Polygon{h.Clone()}
+		hole.Orient(CCW)
+		result = append(result, hole)
+	}
+
+	return result
+}
+
+// RepairHoles returns the polygon without the holes that are not inside the
+// outer ring, e.g. because of bad data, along with the indexes of the dropped
+// rings in the original polygon. A hole is kept if all its points are inside or
+// on the boundary of the outer ring. The result is a new slice of rings but the
+// rings themselves are shared with the original polygon.
+func (p Polygon) RepairHoles() (Polygon, []int) {
+	if len(p) <= 1 {
+		return p, nil
+	}
+
+	outer := p[0]
+	bound := outer.Bound()
+
+	var dropped []int
+	result := make(Polygon, 1, len(p))
+	result[0] = outer
+	for i, h := range p[1:] {
+		if !ringCoversRing(outer, bound, h) {
+			dropped = append(dropped, i+1)
+			continue
+		}
+
+		result = append(result, h)
+	}
+
+	return result, dropped
+}
+
+// ringCoversRing returns true if all the points of the inner ring
+// are inside or on the boundary of the outer ring.
+func ringCoversRing(outer Ring, bound Bound, inner Ring) bool {
+	for _, pt := range inner {
+		if !bound.Contains(pt) || !ringCoversPoint(outer, pt) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// ringCoversPoint returns true if the point is inside
+// or on the boundary of the ring, using ray casting.
+func ringCoversPoint(r Ring, pt Point) bool {
+	if len(r) < 3 {
+		return false
+	}
+
+	in := false
+	for i, j := 0, len(r)-1; i < len(r); j, i = i, i+1 {
+		a, b := r[j], r[i]
+
+		// on the segment
+		cross := (b[0]-a[0])*(pt[1]-a[1]) - (b[1]-a[1])*(pt[0]-a[0])
+		if cross == 0 &&
+			math.Min(a[0], b[0]) <= pt[0] && pt[0] <= math.Max(a[0], b[0]) &&
+			math.Min(a[1], b[1]) <= pt[1] && pt[1] <= math.Max(a[1], b[1]) {
+			return true
+		}
+
+		if (a[1] > pt[1]) != (b[1] > pt[1]) &&
+			pt[0] < (b[0]-a[0])*(pt[1]-a[1])/(b[1]-a[1])+a[0] {
+			in = !in
+		}
+	}
+
+	return in
+}
+
+// EachRing calls the function with the index, ring and orientation of every
+// ring in the polygon, the outer ring first. The orientation is computed once
+// per ring, empty rings have an orientation of 0. Returning false from the
+// function stops the iteration. This is useful to check the winding of the
+// rings, the outer ring is expected to be CCW and the holes CW.
+func (p Polygon) EachRing(f func(i int, r Ring, o Orientation) bool) {
+	for i, r := range p {
+		var o Orientation
+		if len(r) > 0 {
+			o = r.Orientation()
+		}
+
+		if !f(i, r, o) {
+			return
+		}
+	}
+}
+
+// Orient changes the winding of the rings so the outer ring has the given
+// orientation and the holes the opposite, e.g. CCW gives the right-hand rule
+// required by GeoJSON. Rings without an area are not changed.
+// This is done inplace, ie. it modifies the original data.
+func (p Polygon) Orient(o Orientation) {
+	for i, r := range p {
+		want := o
+		if i > 0 {
+			want = -o
+		}
+
+		if len(r) > 0 {
+			if ro := r.Orientation(); ro != 0 && ro != want {
+				r.Reverse()
+			}
+		}
+	}
+}
+
 // Bound returns a bound around the polygon.
 func (p Polygon) Bound() Bound {
 	if len(p) == 0 {
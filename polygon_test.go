@@ -0,0 +1,170 @@
+package orb
+
+import (
+	"testing"
+)
+
+func TestPolygonExteriorHoles(t *testing.T) {
+	exterior := Ring{{0, 0}, {4, 0}, {4, 4}, {0, 4}, {0, 0}}
+	hole1 := Ring{{1, 1}, {1, 2}, {2, 2}, {2, 1}, {1, 1}}
+	hole2 := Ring{{3, 3}, {3, 3.5}, {3.5, 3.5}, {3.5, 3}, {3, 3}}
+
+	p := Polygon{exterior, hole1, hole2}
+	if v := p.Exterior(); !v.Equal(exterior) {
+		t.Errorf("incorrect exterior: %v", v)
+	}
+
+	holes := p.Holes()
+	if len(holes) != 2 || !holes[0].Equal(hole1) || !holes[1].Equal(hole2) {
+		t.Errorf("incorrect holes: %v", holes)
+	}
+
+	p = Polygon{exterior}
+	if v := p.Holes(); v != nil {
+		t.Errorf("should have no holes: %v", v)
+	}
+
+	p = Polygon{}
+	if v := p.Exterior(); v != nil {
+		t.Errorf("empty polygon should have nil exterior: %v", v)
+	}
+
+	if v := p.Holes(); v != nil {
+		t.Errorf("empty polygon should have no holes: %v", v)
+	}
+}
+
+func TestPolygonHolesAsPolygons(t *testing.T) {
+	p := Polygon{
+		{{0, 0}, {4, 0}, {4, 4}, {0, 4}, {0, 0}},
+		{{1, 1}, {1, 2}, {2, 2}, {2, 1}, {1, 1}},
+		{{3, 3}, {3.5, 3}, {3.5, 3.5}, {3, 3.5}, {3, 3}},
+	}
+	original := p.Clone()
+
+	holes := p.HolesAsPolygons()
+	expected := []Polygon{
+		{{{1, 1}, {2, 1}, {2, 2}, {1, 2}, {1, 1}}},
+		{{{3, 3}, {3.5, 3}, {3.5, 3.5}, {3, 3.5}, {3, 3}}},
+	}
+
+	if len(holes) != len(expected) {
+		t.Fatalf("incorrect number of holes: %v", len(holes))
+	}
+
+	for i := range expected {
+		if !holes[i].Equal(expected[i]) {
+			t.Errorf("incorrect hole %d: %v", i, holes[i])
+		}
+	}
+
+	if !p.Equal(original) {
+		t.Errorf("should not modify the polygon: %v", p)
+	}
+
+	if holes := p[:1].HolesAsPolygons(); holes != nil {
+		t.Errorf("should be nil without holes: %v", holes)
+	}
+}
+
+func TestPolygonRepairHoles(t *testing.T) {
+	p := Polygon{
+		{{0, 0}, {4, 0}, {4, 4}, {2, 2}, {0, 4}, {0, 0}},
+		{{1, 1}, {1, 2}, {2, 2}, {2, 1}, {1, 1}},    // touches the boundary
+		{{5, 5}, {5, 6}, {6, 6}, {6, 5}, {5, 5}},    // outside
+		{{2, 2.5}, {2, 3}, {2.5, 3}, {2, 2.5}},      // in the notch
+		{{3, 0.5}, {3, 1}, {3.5, 1}, {3, 0.5}},      // inside
+		{{-1, 1}, {-1, 2}, {1, 2}, {1, 1}, {-1, 1}}, // crosses the outer ring
+	}
+	original := p.Clone()
+
+	repaired, dropped := p.RepairHoles()
+
+	expected := Polygon{p[0], p[1], p[4]}
+	if !repaired.Equal(expected) {
+		t.Errorf("incorrect polygon: %v", repaired)
+	}
+
+	if len(dropped) != 3 || dropped[0] != 2 || dropped[1] != 3 || dropped[2] != 5 {
+		t.Errorf("incorrect dropped rings: %v", dropped)
+	}
+
+	if !p.Equal(original) {
+		t.Errorf("should not modify the polygon: %v", p)
+	}
+
+	repaired, dropped = p[:1].RepairHoles()
+	if !repaired.Equal(p[:1]) || dropped != nil {
+		t.Errorf("should not change without holes: %v %v", repaired, dropped)
+	}
+}
+
+func TestPolygonEachRing(t *testing.T) {
+	p := Polygon{
+		{{0, 0}, {4, 0}, {4, 4}, {0, 4}, {0, 0}},
+		{{1, 1}, {1, 2}, {2, 2}, {2, 1}, {1, 1}},
+		{},
+		{{3, 3}, {3.5, 3}, {3.5, 3.5}, {3, 3.5}, {3, 3}},
+	}
+
+	var orientations []Orientation
+	p.EachRing(func(i int, r Ring, o Orientation) bool {
+		if !r.Equal(p[i]) {
+			t.Errorf("incorrect ring %d: %v", i, r)
+		}
+
+		orientations = append(orientations, o)
+		return true
+	})
+
+	expected := []Orientation{CCW, CW, 0, CCW}
+	if len(orientations) != len(expected) {
+		t.Fatalf("incorrect number of rings: %v", orientations)
+	}
+
+	for i := range expected {
+		if orientations[i] != expected[i] {
+			t.Errorf("incorrect orientation %d: %v != %v", i, orientations[i], expected[i])
+		}
+	}
+
+	count := 0
+	p.EachRing(func(int, Ring, Orientation) bool {
+		count++
+		return false
+	})
+
+	if count != 1 {
+		t.Errorf("should stop after the first ring: %v", count)
+	}
+}
+
+func TestPolygonOrient(t *testing.T) {
+	p := Polygon{
+		{{0, 0}, {0, 4}, {4, 4}, {4, 0}, {0, 0}},
+		{{1, 1}, {1, 2}, {2, 2}, {2, 1}, {1, 1}},
+		{},
+		{{3, 3}, {3.5, 3}, {3.5, 3.5}, {3, 3.5}, {3, 3}},
+		{{1, 1}, {2, 2}, {1, 1}},
+	}
+
+	p.Orient(CCW)
+
+	expected := []Orientation{CCW, CW, 0, CW, 0}
+	p.EachRing(func(i int, r Ring, o Orientation) bool {
+		if o != expected[i] {
+			t.Errorf("incorrect orientation %d: %v != %v", i, o, expected[i])
+		}
+		return true
+	})
+
+	p.Orient(CW)
+
+	expected = []Orientation{CW, CCW, 0, CCW, 0}
+	p.EachRing(func(i int, r Ring, o Orientation) bool {
+		if o != expected[i] {
+			t.Errorf("incorrect orientation %d: %v != %v", i, o, expected[i])
+		}
+		return true
+	})
+}
@@ -14,7 +14,7 @@ func ExamplePoint_toMercator() {
 
 	fmt.Println(merc)
 	// Output:
-	// [-1.3627361035049736e+07 4.548863085837512e+06]
+	// POINT(-1.3627361035049736e+07 4.548863085837512e+06)
 }
 
 func ExamplePolygon() {
@@ -34,5 +34,5 @@ func ExamplePolygon() {
 	centroid = project.Mercator.ToWGS84(centroid)
 	fmt.Println(centroid)
 	// Output:
-	// [-122.41574403384001 37.77909471899779]
+	// POINT(-122.41574403384001 37.77909471899779)
 }
@@ -165,3 +165,30 @@ func BenchmarkRandomKNearest100(b *testing.B) {
 		qt.KNearest(buf[:0], orb.Point{r.Float64(), r.Float64()}, 100)
 	}
 }
+
+func BenchmarkWideKNearest10(b *testing.B) {
+	benchmarkWideKNearest(b)
+}
+
+func BenchmarkWideKNearest10SquareCells(b *testing.B) {
+	benchmarkWideKNearest(b, SquareCells(true))
+}
+
+// benchmarkWideKNearest uses a bound with a 100:1 aspect ratio
+// and points clustered along the long side.
+func benchmarkWideKNearest(b *testing.B, opts ...Option) {
+	r := rand.New(rand.NewSource(43))
+
+	qt := New(orb.Bound{Min: orb.Point{0, 0}, Max: orb.Point{100, 1}}, opts...)
+	for i := 0; i < 10000; i++ {
+		qt.Add(orb.Point{100 * r.Float64(), r.Float64()})
+	}
+
+	buf := make([]orb.Pointer, 0, 10)
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		qt.KNearest(buf[:0], orb.Point{100 * r.Float64(), r.Float64()}, 10)
+	}
+}
@@ -22,7 +22,7 @@ func ExampleQuadtree_Find() {
 	fmt.Printf("nearest: %+v\n", nearest)
 
 	// Output:
-	// nearest: [0.4930591659434973 0.5196585530161364]
+	// nearest: POINT(0.4930591659434973 0.5196585530161364)
 }
 
 func ExampleQuadtree_Matching() {
@@ -50,7 +50,7 @@ func ExampleQuadtree_Matching() {
 	fmt.Printf("nearest: %+v\n", nearest)
 
 	// Output:
-	// nearest: {Pointer:[0 0] visible:true}
+	// nearest: {Pointer:POINT(0 0) visible:true}
 }
 
 func ExampleQuadtree_InBound() {
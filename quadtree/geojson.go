@@ -0,0 +1,22 @@
+package quadtree
+
+import (
+	"github.com/paulmach/orb"
+	"github.com/paulmach/orb/geojson"
+)
+
+// ToGeoJSON returns the points in the tree as a GeoJSON feature collection
+// of point features, e.g. for debugging or visualizing the index. Values
+// removed with RemoveLazy are not included. An empty tree returns an empty
+// feature collection.
+func ToGeoJSON(q *Quadtree) ([]byte, error) {
+	fc := geojson.NewFeatureCollection()
+	q.WalkNodes(func(_ orb.Bound, value orb.Pointer, _ int) bool {
+		if value != nil {
+			fc.Append(geojson.NewFeature(value.Point()))
+		}
+		return true
+	})
+
+	return fc.MarshalJSON()
+}
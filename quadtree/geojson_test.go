@@ -0,0 +1,52 @@
+package quadtree
+
+import (
+	"testing"
+
+	"github.com/paulmach/orb"
+	"github.com/paulmach/orb/geojson"
+)
+
+func TestToGeoJSON(t *testing.T) {
+	qt := New(orb.Bound{Min: orb.Point{0, 0}, Max: orb.Point{10, 10}})
+
+	data, err := ToGeoJSON(qt)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if s := string(data); s != `{"features":[],"type":"FeatureCollection"}` {
+		t.Errorf("incorrect empty collection: %v", s)
+	}
+
+	mp := orb.MultiPoint{{1, 2}, {3, 4}, {5, 6}, {7, 8}}
+	for _, p := range mp {
+		qt.Add(p)
+	}
+	qt.RemoveLazy(orb.Point{3, 4}, nil)
+
+	data, err = ToGeoJSON(qt)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	fc, err := geojson.UnmarshalFeatureCollection(data)
+	if err != nil {
+		t.Fatalf("unmarshal error: %v", err)
+	}
+
+	if l := len(fc.Features); l != 3 {
+		t.Fatalf("incorrect number of features: %d", l)
+	}
+
+	for _, f := range fc.Features {
+		p, ok := f.Geometry.(orb.Point)
+		if !ok {
+			t.Fatalf("should be a point: %T", f.Geometry)
+		}
+
+		if p.Equal(orb.Point{3, 4}) || !(p.Equal(mp[0]) || p.Equal(mp[2]) || p.Equal(mp[3])) {
+			t.Errorf("incorrect point: %v", p)
+		}
+	}
+}
@@ -56,6 +56,10 @@ func (h *maxHeap) Push(point orb.Pointer, distance float64) {
 func (h *maxHeap) Pop() *heapItem {
 	removed := (*h)[0]
 	lastItem := (*h)[len(*h)-1]
+
+	// keep the removed item in the freed slot so the next Push reuses it,
+	// leaving lastItem there would have it in the heap twice.
+	(*h)[len(*h)-1] = removed
 	(*h) = (*h)[:len(*h)-1]
 
 	mh := (*h)
@@ -2,6 +2,7 @@ package quadtree
 
 import (
 	"math/rand"
+	"sort"
 	"testing"
 )
 
@@ -25,3 +26,29 @@ func TestMaxHeap(t *testing.T) {
 		}
 	}
 }
+
+func TestMaxHeap_pushPop(t *testing.T) {
+	r := rand.New(rand.NewSource(22))
+
+	// keep the k smallest like the k nearest search does
+	k := 5
+	h := make(maxHeap, 0, k+1)
+
+	values := make([]float64, 0, 100)
+	for i := 0; i < 100; i++ {
+		d := r.Float64()
+		values = append(values, d)
+
+		h.Push(nil, d)
+		if len(h) > k {
+			h.Pop()
+		}
+	}
+
+	sort.Float64s(values)
+	for i := k - 1; i >= 0; i-- {
+		if d := h.Pop().distance; d != values[i] {
+			t.Errorf("incorrect value: %v != %v", d, values[i])
+		}
+	}
+}
@@ -0,0 +1,29 @@
+package quadtree
+
+type options struct {
+	squareCells    bool
+	trackDataBound bool
+}
+
+// An Option is a possible parameter when creating a quadtree.
+type Option func(*options)
+
+// SquareCells is an option to subdivide the tree using a square bound that
+// contains the tree's bound. Splitting a very non-square bound in half results
+// in eccentric cells that skew the pruning of nearest neighbor searches.
+// Points must still be within the original bound to be added.
+func SquareCells(yes bool) Option {
+	return func(o *options) {
+		o.squareCells = yes
+	}
+}
+
+// TrackDataBound is an option to maintain the bound around the stored points
+// as they are added, making DataBound constant time. Removing a point on the
+// edge of that bound marks it as stale and the next call to DataBound walks
+// the tree to recompute it.
+func TrackDataBound(yes bool) Option {
+	return func(o *options) {
+		o.trackDataBound = yes
+	}
+}
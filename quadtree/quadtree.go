@@ -5,8 +5,10 @@
 package quadtree
 
 import (
+	"context"
 	"errors"
 	"math"
+	"unsafe"
 
 	"github.com/paulmach/orb"
 	"github.com/paulmach/orb/planar"
@@ -23,6 +25,21 @@ var (
 type Quadtree struct {
 	bound orb.Bound
 	root  *node
+
+	// cells is the bound that is subdivided, it is a square
+	// around the bound if the SquareCells option is used.
+	cells orb.Bound
+
+	// free holds nodes detached during Remove so they
+	// can be reused by Add without allocating.
+	free []*node
+
+	// dataBound is the bound around the stored points, maintained
+	// if the TrackDataBound option is used. It needs to be recomputed
+	// if stale, after a point on its edge is removed.
+	trackDataBound bool
+	dataBound      orb.Bound
+	dataBoundStale bool
 }
 
 // A FilterFunc is a function that filters the points to search for.
@@ -37,8 +54,42 @@ type node struct {
 
 // New creates a new quadtree for the given bound. Added points
 // must be within this bound.
-func New(bound orb.Bound) *Quadtree {
-	return &Quadtree{bound: bound}
+func New(bound orb.Bound, opts ...Option) *Quadtree {
+	o := &options{}
+	for _, opt := range opts {
+		opt(o)
+	}
+
+	cells := bound
+	if o.squareCells {
+		cells = squareBound(bound)
+	}
+
+	return &Quadtree{
+		bound:          bound,
+		cells:          cells,
+		trackDataBound: o.trackDataBound,
+		dataBound:      orb.Bound{Min: orb.Point{1, 1}, Max: orb.Point{-1, -1}},
+	}
+}
+
+// squareBound returns the square with the same center as the bound
+// and sides equal to the longest side of the bound.
+func squareBound(b orb.Bound) orb.Bound {
+	w := b.Max[0] - b.Min[0]
+	h := b.Max[1] - b.Min[1]
+
+	if w > h {
+		d := (w - h) / 2
+		b.Min[1] -= d
+		b.Max[1] += d
+	} else if h > w {
+		d := (h - w) / 2
+		b.Min[0] -= d
+		b.Max[0] += d
+	}
+
+	return b
 }
 
 // Bound returns the bounds used for the quad tree.
@@ -46,7 +97,88 @@ func (q *Quadtree) Bound() orb.Bound {
 	return q.bound
 }
 
+// EstimatedBytes returns an estimate of the memory used by the tree structure.
+// It counts the nodes, including empty ones and those kept for reuse after
+// a remove. The memory used by the values themselves is not included.
+func (q *Quadtree) EstimatedBytes() uintptr {
+	nodes := uintptr(countNodes(q.root) + len(q.free))
+
+	return unsafe.Sizeof(*q) +
+		nodes*unsafe.Sizeof(node{}) +
+		uintptr(cap(q.free))*unsafe.Sizeof((*node)(nil))
+}
+
+// DataBound returns the bound around the points actually stored in the tree,
+// as opposed to the bound used to create the tree. Values removed with
+// RemoveLazy are not included. An empty tree returns an empty bound,
+// ie. IsEmpty() is true. The tree is walked to find the bound unless the
+// TrackDataBound option is used. With the option, if the bound is stale after
+// a remove it is recomputed and saved, so the first call after a remove is
+// not thread-safe.
+func (q *Quadtree) DataBound() orb.Bound {
+	if !q.trackDataBound {
+		return q.computeDataBound()
+	}
+
+	if q.dataBoundStale {
+		q.dataBound = q.computeDataBound()
+		q.dataBoundStale = false
+	}
+
+	return q.dataBound
+}
+
+// removedFromDataBound marks the tracked data bound as stale
+// if the removed point is on its edge.
+func (q *Quadtree) removedFromDataBound(p orb.Point) {
+	if !q.trackDataBound || q.dataBoundStale {
+		return
+	}
+
+	b := q.dataBound
+	if p[0] == b.Min[0] || p[0] == b.Max[0] || p[1] == b.Min[1] || p[1] == b.Max[1] {
+		q.dataBoundStale = true
+	}
+}
+
+func (q *Quadtree) computeDataBound() orb.Bound {
+	b := orb.Bound{Min: orb.Point{1, 1}, Max: orb.Point{-1, -1}}
+
+	var walk func(n *node)
+	walk = func(n *node) {
+		if n == nil {
+			return
+		}
+
+		if n.Value != nil {
+			b = b.Extend(n.Value.Point())
+		}
+
+		for _, c := range n.Children {
+			walk(c)
+		}
+	}
+	walk(q.root)
+
+	return b
+}
+
+func countNodes(n *node) int {
+	if n == nil {
+		return 0
+	}
+
+	count := 1
+	for _, c := range n.Children {
+		count += countNodes(c)
+	}
+
+	return count
+}
+
 // Add puts an object into the quad tree, must be within the quadtree bounds.
+// Multiple objects can be added at the exact same point, they are all stored
+// and can be retrieved using FindAll or removed individually with a FilterFunc.
 // This function is not thread-safe, ie. multiple goroutines cannot insert into
 // a single quadtree.
 func (q *Quadtree) Add(p orb.Pointer) error {
@@ -59,18 +191,26 @@ func (q *Quadtree) Add(p orb.Pointer) error {
 		return ErrPointOutsideOfBounds
 	}
 
+	if q.trackDataBound && !q.dataBoundStale {
+		q.dataBound = q.dataBound.Extend(point)
+	}
+
 	if q.root == nil {
-		q.root = &node{
-			Value: p,
-		}
+		q.root = q.newNode(p)
+		return nil
+	}
+
+	if q.root.Value == nil {
+		// all the values have been removed
+		q.root.Value = p
 		return nil
 	}
 
 	q.add(q.root, p, p.Point(),
-		// q.bound.Left(), q.bound.Right(),
-		// q.bound.Bottom(), q.bound.Top(),
-		q.bound.Min[0], q.bound.Max[0],
-		q.bound.Min[1], q.bound.Max[1],
+		// q.cells.Left(), q.cells.Right(),
+		// q.cells.Bottom(), q.cells.Top(),
+		q.cells.Min[0], q.cells.Max[0],
+		q.cells.Min[1], q.cells.Max[1],
 	)
 
 	return nil
@@ -96,7 +236,13 @@ func (q *Quadtree) add(n *node, p orb.Pointer, point orb.Point, left, right, bot
 	}
 
 	if n.Children[i] == nil {
-		n.Children[i] = &node{Value: p}
+		n.Children[i] = q.newNode(p)
+		return
+	}
+
+	// an empty node is a leaf whose value was removed, reuse it.
+	if n.Children[i].Value == nil {
+		n.Children[i].Value = p
 		return
 	}
 
@@ -127,22 +273,106 @@ func (q *Quadtree) Remove(p orb.Pointer, eq FilterFunc) bool {
 	}
 
 	newVisit(v).Visit(q.root,
-		// q.bound.Left(), q.bound.Right(),
-		// q.bound.Bottom(), q.bound.Top(),
-		q.bound.Min[0], q.bound.Max[0],
-		q.bound.Min[1], q.bound.Max[1],
+		// q.cells.Left(), q.cells.Right(),
+		// q.cells.Bottom(), q.cells.Top(),
+		q.cells.Min[0], q.cells.Max[0],
+		q.cells.Min[1], q.cells.Max[1],
 	)
 
 	if v.closest == nil {
 		return false
 	}
 
-	removeNode(v.closest)
+	q.removedFromDataBound(v.closest.Value.Point())
+	q.removeNode(v.closest)
 	return true
 }
 
+// RemoveLazy removes the pointer from the quadtree, matching the same way as
+// Remove, but only clears the value of the node without restructuring the tree.
+// This is cheaper for many removes, the empty nodes are skipped by queries and
+// reused by Add. Call Compact to rebuild the tree without the empty nodes.
+func (q *Quadtree) RemoveLazy(p orb.Pointer, eq FilterFunc) bool {
+	if q.root == nil {
+		return false
+	}
+
+	if eq == nil {
+		point := p.Point()
+		eq = func(pointer orb.Pointer) bool {
+			return point.Equal(pointer.Point())
+		}
+	}
+
+	b := q.bound
+	v := &findVisitor{
+		point:          p.Point(),
+		filter:         eq,
+		closestBound:   &b,
+		minDistSquared: math.MaxFloat64,
+	}
+
+	newVisit(v).Visit(q.root,
+		q.cells.Min[0], q.cells.Max[0],
+		q.cells.Min[1], q.cells.Max[1],
+	)
+
+	if v.closest == nil {
+		return false
+	}
+
+	q.removedFromDataBound(v.closest.Value.Point())
+	v.closest.Value = nil
+	return true
+}
+
+// Compact rebuilds the tree with only the nodes that have values, removing
+// the empty nodes left by RemoveLazy. The free list is also released.
+// This function is not thread-safe.
+func (q *Quadtree) Compact() {
+	var values []orb.Pointer
+	var collect func(n *node)
+	collect = func(n *node) {
+		if n == nil {
+			return
+		}
+
+		if n.Value != nil {
+			values = append(values, n.Value)
+		}
+
+		for _, c := range n.Children {
+			collect(c)
+		}
+	}
+	collect(q.root)
+
+	q.root = nil
+	q.free = nil
+	q.dataBound = orb.Bound{Min: orb.Point{1, 1}, Max: orb.Point{-1, -1}}
+	q.dataBoundStale = false
+	for _, v := range values {
+		q.Add(v)
+	}
+}
+
+// newNode returns a node from the free list, or allocates one if it's empty.
+func (q *Quadtree) newNode(p orb.Pointer) *node {
+	if l := len(q.free); l > 0 {
+		n := q.free[l-1]
+		q.free[l-1] = nil
+		q.free = q.free[:l-1]
+
+		n.Value = p
+		return n
+	}
+
+	return &node{Value: p}
+}
+
 // removeNode is the recursive fixing up of the tree when we remove a node.
-func removeNode(n *node) {
+// Empty leaves that are detached are put on the free list.
+func (q *Quadtree) removeNode(n *node) {
 	var i int
 	for {
 		i = -1
@@ -161,7 +391,15 @@ func removeNode(n *node) {
 			return
 		}
 
-		if n.Children[i].Value == nil {
+		c := n.Children[i]
+		if c.Value == nil && (c.Children[0] != nil || c.Children[1] != nil ||
+			c.Children[2] != nil || c.Children[3] != nil) {
+			// emptied by RemoveLazy, pull a value up from below
+			q.removeNode(c)
+		}
+
+		if c.Value == nil {
+			q.free = append(q.free, c)
 			n.Children[i] = nil
 			continue
 		}
@@ -170,7 +408,7 @@ func removeNode(n *node) {
 	}
 
 	n.Value = n.Children[i].Value
-	removeNode(n.Children[i])
+	q.removeNode(n.Children[i])
 }
 
 // Find returns the closest Value/Pointer in the quadtree.
@@ -180,6 +418,14 @@ func (q *Quadtree) Find(p orb.Point) orb.Pointer {
 	return q.Matching(p, nil)
 }
 
+// FindAll returns all the Values/Pointers in the quadtree at exactly the given point.
+// This is useful if multiple objects were added with the same coordinates.
+// This function is thread safe. Multiple goroutines can read from
+// a pre-created tree.
+func (q *Quadtree) FindAll(p orb.Point) []orb.Pointer {
+	return q.InBound(nil, p.Bound())
+}
+
 // Matching returns the closest Value/Pointer in the quadtree for which
 // the given filter function returns true. This function is thread safe.
 // Multiple goroutines can read from a pre-created tree.
@@ -197,10 +443,10 @@ func (q *Quadtree) Matching(p orb.Point, f FilterFunc) orb.Pointer {
 	}
 
 	newVisit(v).Visit(q.root,
-		// q.bound.Left(), q.bound.Right(),
-		// q.bound.Bottom(), q.bound.Top(),
-		q.bound.Min[0], q.bound.Max[0],
-		q.bound.Min[1], q.bound.Max[1],
+		// q.cells.Left(), q.cells.Right(),
+		// q.cells.Bottom(), q.cells.Top(),
+		q.cells.Min[0], q.cells.Max[0],
+		q.cells.Min[1], q.cells.Max[1],
 	)
 
 	if v.closest == nil {
@@ -214,6 +460,8 @@ func (q *Quadtree) Matching(p orb.Point, f FilterFunc) orb.Pointer {
 // An optional buffer parameter is provided to allow for the reuse of result slice memory.
 // The points are returned in a sorted order, nearest first.
 // This function allows defining a maximum distance in order to reduce search iterations.
+// Distances, and the pruning of the search, are planar in the tree's coordinates.
+// For lon/lat data this can mis-rank neighbors, see KNearestDistance.
 func (q *Quadtree) KNearest(buf []orb.Pointer, p orb.Point, k int, maxDistance ...float64) []orb.Pointer {
 	return q.KNearestMatching(buf, p, k, nil, maxDistance...)
 }
@@ -225,8 +473,29 @@ func (q *Quadtree) KNearest(buf []orb.Pointer, p orb.Point, k int, maxDistance .
 // The points are returned in a sorted order, nearest first.
 // This function allows defining a maximum distance in order to reduce search iterations.
 func (q *Quadtree) KNearestMatching(buf []orb.Pointer, p orb.Point, k int, f FilterFunc, maxDistance ...float64) []orb.Pointer {
+	buf, _ = q.kNearest(nil, buf, p, k, f, maxDistance...)
+	return buf
+}
+
+// KNearestCtx returns the k closest Value/Pointers in the quadtree, the same
+// as KNearest, but checks the context periodically during the search. If the
+// context is done the search stops and the results found so far are returned,
+// along with the context's error. These partial results are sorted, nearest
+// first, but may not be the actual closest points.
+// This function is thread safe. Multiple goroutines can read from a pre-created tree.
+func (q *Quadtree) KNearestCtx(ctx context.Context, buf []orb.Pointer, p orb.Point, k int, maxDistance ...float64) ([]orb.Pointer, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	return q.kNearest(ctx, buf, p, k, nil, maxDistance...)
+}
+
+// kNearest implements the k nearest searches. The ctx may be nil, which skips
+// the cancellation checks so the plain queries do not pay for them.
+func (q *Quadtree) kNearest(ctx context.Context, buf []orb.Pointer, p orb.Point, k int, f FilterFunc, maxDistance ...float64) ([]orb.Pointer, error) {
 	if q.root == nil {
-		return nil
+		return nil, nil
 	}
 
 	b := q.bound
@@ -243,11 +512,53 @@ func (q *Quadtree) KNearestMatching(buf []orb.Pointer, p orb.Point, k int, f Fil
 		v.maxDistSquared = maxDistance[0] * maxDistance[0]
 	}
 
+	vis := newVisit(v)
+	vis.ctx = ctx
+	vis.Visit(q.root,
+		// q.cells.Left(), q.cells.Right(),
+		// q.cells.Bottom(), q.cells.Top(),
+		q.cells.Min[0], q.cells.Max[0],
+		q.cells.Min[1], q.cells.Max[1],
+	)
+
+	//repack result
+	if cap(buf) < len(v.maxHeap) {
+		buf = make([]orb.Pointer, len(v.maxHeap))
+	} else {
+		buf = buf[:len(v.maxHeap)]
+	}
+
+	for i := len(v.maxHeap) - 1; i >= 0; i-- {
+		buf[i] = v.maxHeap.Pop().point
+	}
+
+	return buf, vis.err
+}
+
+// KNearestWeighted returns the k Value/Pointers in the quadtree with the smallest
+// weighted distance. The weight function maps a pointer and its distance from
+// the query point to the effective distance used for ordering. Since the weight
+// may not be related to the distance the whole tree is searched.
+// This function is thread safe. Multiple goroutines can read from a pre-created tree.
+// An optional buffer parameter is provided to allow for the reuse of result slice memory.
+// The points are returned in a sorted order, smallest weighted distance first.
+func (q *Quadtree) KNearestWeighted(buf []orb.Pointer, p orb.Point, k int, weight func(orb.Pointer, float64) float64) []orb.Pointer {
+	if q.root == nil {
+		return nil
+	}
+
+	b := q.bound
+	v := &weightedVisitor{
+		point:   p,
+		weight:  weight,
+		k:       k,
+		maxHeap: make(maxHeap, 0, k+1),
+		bound:   &b,
+	}
+
 	newVisit(v).Visit(q.root,
-		// q.bound.Left(), q.bound.Right(),
-		// q.bound.Bottom(), q.bound.Top(),
-		q.bound.Min[0], q.bound.Max[0],
-		q.bound.Min[1], q.bound.Max[1],
+		q.cells.Min[0], q.cells.Max[0],
+		q.cells.Min[1], q.cells.Max[1],
 	)
 
 	//repack result
@@ -264,6 +575,82 @@ func (q *Quadtree) KNearestMatching(buf []orb.Pointer, p orb.Point, k int, f Fil
 	return buf
 }
 
+// KNearestDistance returns the k closest Value/Pointers in the quadtree as
+// measured by the given distance function, e.g. geo.Distance for lon/lat data.
+// Cells are searched nearest first and pruned once df(p, c), where c is p
+// clamped to the cell bound, is not less than the current k-th distance.
+// This lower bound requires the metric to grow monotonically with the planar
+// distance from p in each axis, as planar distance does and as haversine
+// does away from the poles. Near the poles great circles bend poleward
+// so a point almost tied with the k-th may be missed.
+// This function is thread safe. Multiple goroutines can read from a pre-created tree.
+// An optional buffer parameter is provided to allow for the reuse of result slice memory.
+// The points are returned in a sorted order, nearest first.
+func (q *Quadtree) KNearestDistance(buf []orb.Pointer, p orb.Point, k int, df orb.DistanceFunc) []orb.Pointer {
+	if q.root == nil || k <= 0 {
+		return nil
+	}
+
+	v := &distanceVisitor{
+		point:   p,
+		df:      df,
+		k:       k,
+		maxHeap: make(maxHeap, 0, k+1),
+	}
+
+	v.Visit(q.root,
+		q.cells.Min[0], q.cells.Max[0],
+		q.cells.Min[1], q.cells.Max[1],
+	)
+
+	//repack result
+	if cap(buf) < len(v.maxHeap) {
+		buf = make([]orb.Pointer, len(v.maxHeap))
+	} else {
+		buf = buf[:len(v.maxHeap)]
+	}
+
+	for i := len(v.maxHeap) - 1; i >= 0; i-- {
+		buf[i] = v.maxHeap.Pop().point
+	}
+
+	return buf
+}
+
+// KFarthest returns the k Value/Pointers in the quadtree that are farthest
+// from the given point, ordered farthest first. An optional buffer parameter
+// is provided to allow for the reuse of result slice memory.
+// This function is thread safe. Multiple goroutines can read from a pre-created tree.
+func (q *Quadtree) KFarthest(buf []orb.Pointer, p orb.Point, k int) []orb.Pointer {
+	if q.root == nil || k <= 0 {
+		return nil
+	}
+
+	v := &farthestVisitor{
+		point: p,
+		k:     k,
+		heap:  make(maxHeap, 0, k+1),
+	}
+
+	v.Visit(q.root,
+		q.cells.Min[0], q.cells.Max[0],
+		q.cells.Min[1], q.cells.Max[1],
+	)
+
+	//repack result
+	if cap(buf) < len(v.heap) {
+		buf = make([]orb.Pointer, len(v.heap))
+	} else {
+		buf = buf[:len(v.heap)]
+	}
+
+	for i := len(v.heap) - 1; i >= 0; i-- {
+		buf[i] = v.heap.Pop().point
+	}
+
+	return buf
+}
+
 // InBound returns a slice with all the pointers in the quadtree that are
 // within the given bound. An optional buffer parameter is provided to allow
 // for the reuse of result slice memory. This function is thread safe.
@@ -292,15 +679,153 @@ func (q *Quadtree) InBoundMatching(buf []orb.Pointer, b orb.Bound, f FilterFunc)
 	}
 
 	newVisit(v).Visit(q.root,
-		// q.bound.Left(), q.bound.Right(),
-		// q.bound.Bottom(), q.bound.Top(),
-		q.bound.Min[0], q.bound.Max[0],
-		q.bound.Min[1], q.bound.Max[1],
+		// q.cells.Left(), q.cells.Right(),
+		// q.cells.Bottom(), q.cells.Top(),
+		q.cells.Min[0], q.cells.Max[0],
+		q.cells.Min[1], q.cells.Max[1],
 	)
 
 	return v.pointers
 }
 
+// InBoundTraced returns the same pointers as InBound along with the bounds of
+// every node the search visited, ie. the cells that intersect the bound and
+// were examined. This is intended for debugging and tuning query selectivity.
+// This function is thread safe. Multiple goroutines can read from a pre-created tree.
+func (q *Quadtree) InBoundTraced(b orb.Bound) ([]orb.Pointer, []orb.Bound) {
+	if q.root == nil {
+		return nil, nil
+	}
+
+	v := &inBoundVisitor{
+		bound: &b,
+	}
+
+	var traced []orb.Bound
+	vis := newVisit(v)
+	vis.traced = &traced
+
+	vis.Visit(q.root,
+		q.cells.Min[0], q.cells.Max[0],
+		q.cells.Min[1], q.cells.Max[1],
+	)
+
+	return v.pointers, traced
+}
+
+// CountInBound returns the number of pointers in the quadtree that are within
+// the given bound and match the filter function, which may be nil. It is cheaper
+// than len(InBound(...)) since no result slice is built. This function is
+// thread safe. Multiple goroutines can read from a pre-created tree.
+func (q *Quadtree) CountInBound(b orb.Bound, f FilterFunc) int {
+	if q.root == nil {
+		return 0
+	}
+
+	v := &countVisitor{
+		bound:  &b,
+		filter: f,
+	}
+
+	newVisit(v).Visit(q.root,
+		// q.cells.Left(), q.cells.Right(),
+		// q.cells.Bottom(), q.cells.Top(),
+		q.cells.Min[0], q.cells.Max[0],
+		q.cells.Min[1], q.cells.Max[1],
+	)
+
+	return v.count
+}
+
+// Density returns a kernel density estimate at the point, e.g. to sample a
+// heatmap on a grid. Every point in the tree within the bandwidth of p
+// contributes 1 - d/bandwidth, a linear kernel, so a point at p counts as 1
+// and points at the bandwidth or further count as 0. The value is not
+// normalized. Only the nodes near p are visited. This function is thread
+// safe. Multiple goroutines can read from a pre-created tree.
+func (q *Quadtree) Density(p orb.Point, bandwidth float64) float64 {
+	if q.root == nil || !(bandwidth > 0) {
+		return 0
+	}
+
+	b := orb.Bound{Min: p, Max: p}.Pad(bandwidth)
+	v := &densityVisitor{
+		bound:     &b,
+		point:     p,
+		bandwidth: bandwidth,
+	}
+
+	newVisit(v).Visit(q.root,
+		q.cells.Min[0], q.cells.Max[0],
+		q.cells.Min[1], q.cells.Max[1],
+	)
+
+	return v.density
+}
+
+// NearestJoin returns, for each pointer in a, the nearest pointer in the tree.
+// The result is aligned by index with the input. If the tree is empty the
+// result contains nil values. This function is thread safe.
+// Multiple goroutines can read from a pre-created tree.
+func NearestJoin(a []orb.Pointer, tree *Quadtree) []orb.Pointer {
+	result := make([]orb.Pointer, len(a))
+	if tree == nil {
+		return result
+	}
+
+	for i, p := range a {
+		if p == nil {
+			continue
+		}
+		result[i] = tree.Find(p.Point())
+	}
+
+	return result
+}
+
+// WalkNodes traverses the nodes of the tree in breadth-first order.
+// The function is called with the bound of the node's partition, the value
+// stored at the node (possibly nil after a remove) and the depth of the node,
+// the root being at depth 0. Returning false from the function stops the walk.
+// This function is thread safe. Multiple goroutines can read from a pre-created tree.
+func (q *Quadtree) WalkNodes(f func(bound orb.Bound, value orb.Pointer, depth int) bool) {
+	if q.root == nil {
+		return
+	}
+
+	type item struct {
+		n     *node
+		bound orb.Bound
+		depth int
+	}
+
+	queue := []item{{n: q.root, bound: q.cells}}
+	for len(queue) > 0 {
+		current := queue[0]
+		queue = queue[1:]
+
+		if !f(current.bound, current.n.Value, current.depth) {
+			return
+		}
+
+		b := current.bound
+		cx := (b.Min[0] + b.Max[0]) / 2.0
+		cy := (b.Min[1] + b.Max[1]) / 2.0
+
+		for i, c := range current.n.Children {
+			if c == nil {
+				continue
+			}
+
+			queue = append(queue, item{
+				n:     c,
+				bound: childBound(i, cx, cy, b),
+				depth: current.depth + 1,
+			})
+		}
+	}
+}
+
 // The visit stuff is a more go like (hopefully) implementation of the
 // d3.quadtree.visit function. It is not exported, but if there is a
 // good use case, it could be.
@@ -322,8 +847,20 @@ type visitor interface {
 // Currently used by the `Find` and `InBound` functions.
 type visit struct {
 	visitor visitor
+
+	// traced, if not nil, collects the bounds of the visited nodes.
+	traced *[]orb.Bound
+
+	// ctx, if not nil, is checked every ctxCheckInterval nodes.
+	// The visit stops and sets err if it is done.
+	ctx     context.Context
+	visited int
+	err     error
 }
 
+// ctxCheckInterval is the number of nodes visited between context checks.
+const ctxCheckInterval = 256
+
 func newVisit(v visitor) *visit {
 	return &visit{
 		visitor: v,
@@ -331,6 +868,19 @@ func newVisit(v visitor) *visit {
 }
 
 func (v *visit) Visit(n *node, left, right, bottom, top float64) {
+	if v.ctx != nil {
+		if v.err != nil {
+			return
+		}
+
+		v.visited++
+		if v.visited%ctxCheckInterval == 0 {
+			if v.err = v.ctx.Err(); v.err != nil {
+				return
+			}
+		}
+	}
+
 	b := v.visitor.Bound()
 	// if left > b.Right() || right < b.Left() ||
 	// 	bottom > b.Top() || top < b.Bottom() {
@@ -341,6 +891,13 @@ func (v *visit) Visit(n *node, left, right, bottom, top float64) {
 		return
 	}
 
+	if v.traced != nil {
+		*v.traced = append(*v.traced, orb.Bound{
+			Min: orb.Point{left, bottom},
+			Max: orb.Point{right, top},
+		})
+	}
+
 	if n.Value != nil {
 		v.visitor.Visit(n)
 	}
@@ -495,6 +1052,138 @@ func (v *nearestVisitor) Visit(n *node) {
 	}
 }
 
+type weightedVisitor struct {
+	point   orb.Point
+	weight  func(orb.Pointer, float64) float64
+	k       int
+	maxHeap maxHeap
+	bound   *orb.Bound
+}
+
+func (v *weightedVisitor) Bound() *orb.Bound {
+	return v.bound
+}
+
+func (v *weightedVisitor) Point() orb.Point {
+	return v.point
+}
+
+func (v *weightedVisitor) Visit(n *node) {
+	d := planar.Distance(n.Value.Point(), v.point)
+	w := v.weight(n.Value, d)
+
+	if v.k > 0 && len(v.maxHeap) == v.k && w >= v.maxHeap[0].distance {
+		return
+	}
+
+	v.maxHeap.Push(n.Value, w)
+	if len(v.maxHeap) > v.k {
+		v.maxHeap.Pop()
+	}
+}
+
+// farthestVisitor walks the tree farthest cell first. It does not use the
+// visit framework since cells are pruned if they are too close, which
+// can not be described by a bound.
+type farthestVisitor struct {
+	point orb.Point
+	k     int
+
+	// heap stores the negative squared distance, so the top
+	// is the closest of the k farthest points found so far.
+	heap maxHeap
+}
+
+func (v *farthestVisitor) Visit(n *node, left, right, bottom, top float64) {
+	if len(v.heap) == v.k {
+		dx := math.Max(math.Abs(v.point[0]-left), math.Abs(v.point[0]-right))
+		dy := math.Max(math.Abs(v.point[1]-bottom), math.Abs(v.point[1]-top))
+		if dx*dx+dy*dy < -v.heap[0].distance {
+			// every point in the cell is closer than what we have
+			return
+		}
+	}
+
+	if n.Value != nil {
+		d := planar.DistanceSquared(n.Value.Point(), v.point)
+		if len(v.heap) < v.k || d > -v.heap[0].distance {
+			v.heap.Push(n.Value, -d)
+			if len(v.heap) > v.k {
+				v.heap.Pop()
+			}
+		}
+	}
+
+	cx := (left + right) / 2.0
+	cy := (bottom + top) / 2.0
+
+	// the child opposite the point is the farthest away
+	i := 3 - childIndex(cx, cy, v.point)
+	for j := i; j < i+4; j++ {
+		c := n.Children[j%4]
+		if c == nil {
+			continue
+		}
+
+		b := childBound(j%4, cx, cy, orb.Bound{
+			Min: orb.Point{left, bottom},
+			Max: orb.Point{right, top},
+		})
+		v.Visit(c, b.Min[0], b.Max[0], b.Min[1], b.Max[1])
+	}
+}
+
+// distanceVisitor walks the tree nearest cell first using a distance
+// function. Like farthestVisitor it does not use the visit framework
+// since cells are pruned using the metric and not a planar bound.
+type distanceVisitor struct {
+	point   orb.Point
+	df      orb.DistanceFunc
+	k       int
+	maxHeap maxHeap
+}
+
+func (v *distanceVisitor) Visit(n *node, left, right, bottom, top float64) {
+	if len(v.maxHeap) == v.k {
+		// the closest point of the cell in each axis
+		c := orb.Point{
+			math.Max(left, math.Min(right, v.point[0])),
+			math.Max(bottom, math.Min(top, v.point[1])),
+		}
+
+		if v.df(v.point, c) >= v.maxHeap[0].distance {
+			return
+		}
+	}
+
+	if n.Value != nil {
+		d := v.df(v.point, n.Value.Point())
+		if len(v.maxHeap) < v.k || d < v.maxHeap[0].distance {
+			v.maxHeap.Push(n.Value, d)
+			if len(v.maxHeap) > v.k {
+				v.maxHeap.Pop()
+			}
+		}
+	}
+
+	cx := (left + right) / 2.0
+	cy := (bottom + top) / 2.0
+
+	i := childIndex(cx, cy, v.point)
+	for j := i; j < i+4; j++ {
+		c := n.Children[j%4]
+		if c == nil {
+			continue
+		}
+
+		b := childBound(j%4, cx, cy, orb.Bound{
+			Min: orb.Point{left, bottom},
+			Max: orb.Point{right, top},
+		})
+		v.Visit(c, b.Min[0], b.Max[0], b.Min[1], b.Max[1])
+	}
+}
+
 type inBoundVisitor struct {
 	bound    *orb.Bound
 	pointers []orb.Pointer
@@ -523,6 +1212,52 @@ func (v *inBoundVisitor) Visit(n *node) {
 	v.pointers = append(v.pointers, n.Value)
 }
 
+type countVisitor struct {
+	bound  *orb.Bound
+	count  int
+	filter FilterFunc
+}
+
+func (v *countVisitor) Bound() *orb.Bound {
+	return v.bound
+}
+
+func (v *countVisitor) Point() (p orb.Point) {
+	return
+}
+
+func (v *countVisitor) Visit(n *node) {
+	if v.filter != nil && !v.filter(n.Value) {
+		return
+	}
+
+	if v.bound.Contains(n.Value.Point()) {
+		v.count++
+	}
+}
+
+type densityVisitor struct {
+	bound     *orb.Bound
+	point     orb.Point
+	bandwidth float64
+	density   float64
+}
+
+func (v *densityVisitor) Bound() *orb.Bound {
+	return v.bound
+}
+
+func (v *densityVisitor) Point() orb.Point {
+	return v.point
+}
+
+func (v *densityVisitor) Visit(n *node) {
+	d := planar.Distance(v.point, n.Value.Point())
+	if d < v.bandwidth {
+		v.density += 1 - d/v.bandwidth
+	}
+}
+
 func childIndex(cx, cy float64, point orb.Point) int {
 	i := 0
 	if point[1] <= cy {
@@ -535,3 +1270,18 @@ func childIndex(cx, cy float64, point orb.Point) int {
 
 	return i
 }
+
+// childBound returns the bound of the i-th child partition of the given bound
+// split at the center point cx, cy. The indexes match those used by childIndex.
+func childBound(i int, cx, cy float64, b orb.Bound) orb.Bound {
+	switch i {
+	case 0:
+		return orb.Bound{Min: orb.Point{b.Min[0], cy}, Max: orb.Point{cx, b.Max[1]}}
+	case 1:
+		return orb.Bound{Min: orb.Point{cx, cy}, Max: b.Max}
+	case 2:
+		return orb.Bound{Min: b.Min, Max: orb.Point{cx, cy}}
+	default:
+		return orb.Bound{Min: orb.Point{cx, b.Min[1]}, Max: orb.Point{b.Max[0], cy}}
+	}
+}
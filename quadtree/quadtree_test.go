@@ -1,12 +1,16 @@
 package quadtree
 
 import (
+	"context"
+	"math"
 	"math/rand"
 	"reflect"
 	"sort"
 	"testing"
+	"unsafe"
 
 	"github.com/paulmach/orb"
+	"github.com/paulmach/orb/geo"
 	"github.com/paulmach/orb/planar"
 )
 
@@ -19,6 +23,54 @@ func TestNew(t *testing.T) {
 	}
 }
 
+func TestNew_squareCells(t *testing.T) {
+	r := rand.New(rand.NewSource(42))
+
+	bound := orb.Bound{Min: orb.Point{0, 0}, Max: orb.Point{100, 1}}
+	qt := New(bound, SquareCells(true))
+	plain := New(bound)
+
+	if !qt.Bound().Equal(bound) {
+		t.Errorf("should use provided bound, got %v", qt.Bound())
+	}
+
+	if err := qt.Add(orb.Point{50, 2}); err != ErrPointOutsideOfBounds {
+		t.Errorf("should reject points outside the original bound: %v", err)
+	}
+
+	for i := 0; i < 1000; i++ {
+		p := orb.Point{100 * r.Float64(), r.Float64()}
+		qt.Add(p)
+		plain.Add(p)
+	}
+
+	qt.WalkNodes(func(b orb.Bound, _ orb.Pointer, depth int) bool {
+		if depth == 0 && (b.Max[0]-b.Min[0] != b.Max[1]-b.Min[1]) {
+			t.Errorf("root cell should be square: %v", b)
+		}
+		return false
+	})
+
+	for i := 0; i < 100; i++ {
+		p := orb.Point{100 * r.Float64(), r.Float64()}
+
+		if v, e := qt.Find(p), plain.Find(p); !v.Point().Equal(e.Point()) {
+			t.Errorf("incorrect find: %v != %v", v, e)
+		}
+
+		v := qt.KNearest(nil, p, 5)
+		e := plain.KNearest(nil, p, 5)
+		if !reflect.DeepEqual(v, e) {
+			t.Errorf("incorrect k nearest: %v != %v", v, e)
+		}
+
+		b := p.Bound().Pad(2)
+		if v, e := qt.InBound(nil, b), plain.InBound(nil, b); len(v) != len(e) {
+			t.Errorf("incorrect in bound: %d != %d", len(v), len(e))
+		}
+	}
+}
+
 func TestQuadtreeAdd(t *testing.T) {
 	p := orb.Point{}
 	qt := New(orb.Bound{Min: orb.Point{0, 0}, Max: orb.Point{1, 1}})
@@ -56,6 +108,161 @@ func TestQuadtreeRemove(t *testing.T) {
 	}
 }
 
+func TestQuadtreeRemove_churn(t *testing.T) {
+	r := rand.New(rand.NewSource(42))
+	qt := New(orb.Bound{Min: orb.Point{0, 0}, Max: orb.Point{1, 1}})
+
+	// add into removed leaves, then remove their parents
+	qt.Add(orb.Point{0.1, 0.1})
+	qt.Add(orb.Point{0.2, 0.2})
+	qt.Remove(orb.Point{0.2, 0.2}, nil)
+	qt.Add(orb.Point{0.21, 0.21})
+	qt.Remove(orb.Point{0.1, 0.1}, nil)
+
+	if v := qt.InBound(nil, qt.Bound()); len(v) != 1 {
+		t.Fatalf("should not lose points: %v", v)
+	}
+	qt.Remove(orb.Point{0.21, 0.21}, nil)
+
+	mp := orb.MultiPoint{}
+	for i := 0; i < 500; i++ {
+		mp = append(mp, orb.Point{r.Float64(), r.Float64()})
+	}
+
+	for round := 0; round < 5; round++ {
+		for _, p := range mp {
+			qt.Add(p)
+		}
+
+		if v := qt.InBound(nil, qt.Bound()); len(v) != len(mp) {
+			t.Fatalf("incorrect number of points: %d != %d", len(v), len(mp))
+		}
+
+		for _, p := range mp {
+			if !qt.Remove(p, nil) {
+				t.Fatalf("should remove point: %v", p)
+			}
+		}
+
+		if v := qt.InBound(nil, qt.Bound()); len(v) != 0 {
+			t.Fatalf("should be empty: %v", v)
+		}
+	}
+
+	// removed nodes are reused
+	pointers := make([]orb.Pointer, len(mp))
+	for i, p := range mp {
+		pointers[i] = p
+	}
+
+	allocs := testing.AllocsPerRun(10, func() {
+		for _, p := range pointers {
+			qt.Add(p)
+		}
+
+		for _, p := range pointers {
+			qt.Remove(p, nil)
+		}
+	})
+
+	// only the removes should allocate, for the visitor
+	var missing orb.Pointer = orb.Point{0.5, 0.5}
+	removeAllocs := testing.AllocsPerRun(10, func() {
+		qt.Remove(missing, nil)
+	})
+
+	if expected := removeAllocs * float64(len(pointers)); allocs > expected {
+		t.Errorf("adds should reuse nodes: %v > %v", allocs, expected)
+	}
+}
+
+func TestQuadtreeRemoveLazy(t *testing.T) {
+	r := rand.New(rand.NewSource(43))
+	qt := New(orb.Bound{Min: orb.Point{0, 0}, Max: orb.Point{1, 1}})
+
+	if qt.RemoveLazy(orb.Point{0.5, 0.5}, nil) {
+		t.Errorf("should not remove from an empty tree")
+	}
+
+	mp := orb.MultiPoint{}
+	for i := 0; i < 1000; i++ {
+		mp = append(mp, orb.Point{r.Float64(), r.Float64()})
+		qt.Add(mp[i])
+	}
+
+	// lazily remove every other point
+	remaining := map[orb.Point]bool{}
+	for i, p := range mp {
+		if i%2 == 0 {
+			if !qt.RemoveLazy(p, nil) {
+				t.Fatalf("should remove point: %v", p)
+			}
+		} else {
+			remaining[p] = true
+		}
+	}
+
+	check := func() {
+		t.Helper()
+
+		all := qt.InBound(nil, qt.Bound())
+		if len(all) != len(remaining) {
+			t.Fatalf("incorrect number of points: %d != %d", len(all), len(remaining))
+		}
+
+		for _, p := range all {
+			if !remaining[p.Point()] {
+				t.Fatalf("removed point returned: %v", p)
+			}
+		}
+
+		if c := qt.CountInBound(qt.Bound(), nil); c != len(remaining) {
+			t.Errorf("incorrect count: %d != %d", c, len(remaining))
+		}
+
+		for i := 0; i < 50; i++ {
+			p := orb.Point{r.Float64(), r.Float64()}
+			for _, v := range qt.KNearest(nil, p, 3) {
+				if !remaining[v.Point()] {
+					t.Fatalf("removed point returned: %v", v)
+				}
+			}
+		}
+	}
+	check()
+
+	// add more points, then remove some normally and some lazily
+	for i := 0; i < 200; i++ {
+		p := orb.Point{r.Float64(), r.Float64()}
+		qt.Add(p)
+		remaining[p] = true
+	}
+
+	i := 0
+	for p := range remaining {
+		if i%3 == 0 {
+			if !qt.Remove(p, nil) {
+				t.Fatalf("should remove point: %v", p)
+			}
+			delete(remaining, p)
+		} else if i%3 == 1 {
+			if !qt.RemoveLazy(p, nil) {
+				t.Fatalf("should remove point: %v", p)
+			}
+			delete(remaining, p)
+		}
+		i++
+	}
+	check()
+
+	qt.Compact()
+	check()
+
+	if n := countNodes(qt.root); n != len(remaining) {
+		t.Errorf("compact should remove empty nodes: %d != %d", n, len(remaining))
+	}
+}
+
 func TestQuadtreeFind(t *testing.T) {
 	points := orb.MultiPoint{}
 	dim := 17
@@ -161,6 +368,72 @@ func TestQuadtreeMatching(t *testing.T) {
 	}
 }
 
+// countdownContext is canceled after Err is called the given number of times.
+type countdownContext struct {
+	context.Context
+	calls int
+}
+
+func (c *countdownContext) Err() error {
+	c.calls--
+	if c.calls < 0 {
+		return context.Canceled
+	}
+
+	return nil
+}
+
+func TestQuadtreeKNearestCtx(t *testing.T) {
+	r := rand.New(rand.NewSource(42))
+
+	qt := New(orb.Bound{Min: orb.Point{0, 0}, Max: orb.Point{1, 1}})
+	for i := 0; i < 10000; i++ {
+		qt.Add(orb.Point{r.Float64(), r.Float64()})
+	}
+
+	p := orb.Point{0.5, 0.5}
+	expected := qt.KNearest(nil, p, 5000)
+
+	result, err := qt.KNearestCtx(context.Background(), nil, p, 5000)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if !reflect.DeepEqual(result, expected) {
+		t.Errorf("should match KNearest")
+	}
+
+	// canceled before the search
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	result, err = qt.KNearestCtx(ctx, nil, p, 5000)
+	if err != context.Canceled {
+		t.Errorf("should return the context error: %v", err)
+	}
+
+	if len(result) != 0 {
+		t.Errorf("should not have results: %d", len(result))
+	}
+
+	// canceled during the search
+	ctx = &countdownContext{Context: context.Background(), calls: 3}
+	result, err = qt.KNearestCtx(ctx, nil, p, 5000)
+	if err != context.Canceled {
+		t.Errorf("should return the context error: %v", err)
+	}
+
+	if len(result) == 0 || len(result) >= 5000 {
+		t.Errorf("should have partial results: %d", len(result))
+	}
+
+	for i := 1; i < len(result); i++ {
+		if planar.DistanceSquared(result[i-1].Point(), p) > planar.DistanceSquared(result[i].Point(), p) {
+			t.Fatalf("partial results should be sorted")
+		}
+	}
+}
+
 func TestQuadtreeKNearest(t *testing.T) {
 	type dataPointer struct {
 		orb.Pointer
@@ -255,6 +528,37 @@ func TestQuadtreeKNearest_sorted(t *testing.T) {
 	}
 }
 
+func TestQuadtreeKNearest_Random(t *testing.T) {
+	r := rand.New(rand.NewSource(42))
+
+	qt := New(orb.Bound{Min: orb.Point{0, 0}, Max: orb.Point{1, 1}})
+	mp := orb.MultiPoint{}
+	for i := 0; i < 1000; i++ {
+		mp = append(mp, orb.Point{r.Float64(), r.Float64()})
+		qt.Add(mp[i])
+	}
+
+	for i := 0; i < 100; i++ {
+		p := orb.Point{r.Float64(), r.Float64()}
+
+		expected := append(orb.MultiPoint{}, mp...)
+		sort.Slice(expected, func(i, j int) bool {
+			return planar.DistanceSquared(expected[i], p) < planar.DistanceSquared(expected[j], p)
+		})
+
+		nearest := qt.KNearest(nil, p, 10)
+		if len(nearest) != 10 {
+			t.Fatalf("index: %d, incorrect number of points: %d", i, len(nearest))
+		}
+
+		for j, n := range nearest {
+			if !n.Point().Equal(expected[j]) {
+				t.Errorf("index: %d, incorrect point %d: %v != %v", i, j, n.Point(), expected[j])
+			}
+		}
+	}
+}
+
 func TestQuadtreeKNearest_DistanceLimit(t *testing.T) {
 	type dataPointer struct {
 		orb.Pointer
@@ -426,3 +730,531 @@ func TestQuadtreeInBound_Random(t *testing.T) {
 		}
 	}
 }
+
+func TestQuadtreeInBoundTraced(t *testing.T) {
+	r := rand.New(rand.NewSource(44))
+
+	qt := New(orb.Bound{Min: orb.Point{0, 0}, Max: orb.Point{1, 1}})
+	for i := 0; i < 500; i++ {
+		qt.Add(orb.Point{r.Float64(), r.Float64()})
+	}
+
+	b := orb.Bound{Min: orb.Point{0.2, 0.3}, Max: orb.Point{0.4, 0.45}}
+	ps, traced := qt.InBoundTraced(b)
+
+	if expected := qt.InBound(nil, b); len(ps) != len(expected) {
+		t.Errorf("incorrect results: %v != %v", len(ps), len(expected))
+	}
+
+	total := 0
+	qt.WalkNodes(func(orb.Bound, orb.Pointer, int) bool {
+		total++
+		return true
+	})
+
+	if len(traced) == 0 || len(traced) >= total {
+		t.Errorf("should trace a subset of the nodes: %v of %v", len(traced), total)
+	}
+
+	if !traced[0].Equal(qt.Bound()) {
+		t.Errorf("first traced node should be the root: %v", traced[0])
+	}
+
+	for _, tb := range traced {
+		if !tb.Intersects(b) {
+			t.Errorf("traced node %v does not intersect the bound", tb)
+		}
+	}
+
+	ps, traced = New(b).InBoundTraced(b)
+	if ps != nil || traced != nil {
+		t.Errorf("empty tree should return nil: %v %v", ps, traced)
+	}
+}
+
+func TestQuadtreeWalkNodes(t *testing.T) {
+	q := New(orb.Bound{Max: orb.Point{4, 4}})
+	q.Add(orb.Point{1, 1})
+	q.Add(orb.Point{3, 3})
+	q.Add(orb.Point{3.5, 3.5})
+
+	var (
+		bounds []orb.Bound
+		depths []int
+	)
+	q.WalkNodes(func(b orb.Bound, v orb.Pointer, depth int) bool {
+		if !b.Contains(v.Point()) {
+			t.Errorf("value %v not within node bound %v", v, b)
+		}
+
+		bounds = append(bounds, b)
+		depths = append(depths, depth)
+		return true
+	})
+
+	expected := []orb.Bound{
+		{Min: orb.Point{0, 0}, Max: orb.Point{4, 4}},
+		{Min: orb.Point{2, 2}, Max: orb.Point{4, 4}},
+		{Min: orb.Point{3, 3}, Max: orb.Point{4, 4}},
+	}
+	if !reflect.DeepEqual(bounds, expected) {
+		t.Errorf("incorrect bounds: %v", bounds)
+	}
+
+	if !reflect.DeepEqual(depths, []int{0, 1, 2}) {
+		t.Errorf("incorrect depths: %v", depths)
+	}
+
+	// stop the walk early
+	count := 0
+	q.WalkNodes(func(b orb.Bound, v orb.Pointer, depth int) bool {
+		count++
+		return false
+	})
+
+	if count != 1 {
+		t.Errorf("should stop walking: %d", count)
+	}
+
+	// empty tree
+	New(orb.Bound{Max: orb.Point{1, 1}}).WalkNodes(func(orb.Bound, orb.Pointer, int) bool {
+		t.Errorf("should not call function for empty tree")
+		return true
+	})
+}
+
+func TestNearestJoin(t *testing.T) {
+	q := New(orb.Bound{Max: orb.Point{5, 5}})
+	q.Add(orb.Point{0, 0})
+	q.Add(orb.Point{5, 5})
+
+	a := []orb.Pointer{orb.Point{1, 1}, nil, orb.Point{4, 3}}
+	result := NearestJoin(a, q)
+
+	expected := []orb.Pointer{orb.Point{0, 0}, nil, orb.Point{5, 5}}
+	if !reflect.DeepEqual(result, expected) {
+		t.Errorf("incorrect result: %v", result)
+	}
+
+	// empty tree
+	result = NearestJoin(a, New(orb.Bound{Max: orb.Point{5, 5}}))
+	if !reflect.DeepEqual(result, []orb.Pointer{nil, nil, nil}) {
+		t.Errorf("should be all nil: %v", result)
+	}
+}
+
+func TestQuadtreeKNearestDistance(t *testing.T) {
+	// at 60 degrees north a degree of longitude is about half as long
+	// as a degree of latitude so planar and geo orderings differ.
+	q := New(orb.Bound{Min: orb.Point{-10, 50}, Max: orb.Point{10, 70}})
+	q.Add(orb.Point{0, 61.0})
+	q.Add(orb.Point{0, 60.7})
+	q.Add(orb.Point{1, 60})
+	q.Add(orb.Point{5, 65})
+
+	p := orb.Point{0, 60}
+
+	planarResult := q.KNearest(nil, p, 1)
+	if v := planarResult[0].Point(); !v.Equal(orb.Point{0, 60.7}) {
+		t.Errorf("incorrect planar nearest: %v", v)
+	}
+
+	result := q.KNearestDistance(nil, p, 3, geo.Distance)
+
+	expected := []orb.Point{{1, 60}, {0, 60.7}, {0, 61.0}}
+	if len(result) != len(expected) {
+		t.Fatalf("incorrect response length: %d != %d", len(result), len(expected))
+	}
+
+	for i, e := range expected {
+		if v := result[i].Point(); !v.Equal(e) {
+			t.Errorf("incorrect point %d: %v != %v", i, v, e)
+		}
+	}
+
+	// should match a brute force sort by geo distance
+	r := rand.New(rand.NewSource(60))
+	qt := New(orb.Bound{Min: orb.Point{-20, 55}, Max: orb.Point{20, 75}})
+	mp := orb.MultiPoint{}
+	for i := 0; i < 500; i++ {
+		pt := orb.Point{-20 + 40*r.Float64(), 55 + 20*r.Float64()}
+		mp = append(mp, pt)
+		qt.Add(pt)
+	}
+
+	for i := 0; i < 50; i++ {
+		p := orb.Point{-20 + 40*r.Float64(), 55 + 20*r.Float64()}
+
+		sorted := append(orb.MultiPoint{}, mp...)
+		sort.Slice(sorted, func(i, j int) bool {
+			return geo.Distance(p, sorted[i]) < geo.Distance(p, sorted[j])
+		})
+
+		result := qt.KNearestDistance(nil, p, 5, geo.Distance)
+		for j := range result {
+			if v := result[j].Point(); !v.Equal(sorted[j]) {
+				t.Fatalf("incorrect point %d: %v != %v", j, v, sorted[j])
+			}
+		}
+	}
+}
+
+func TestQuadtreeKNearestDistance_pruned(t *testing.T) {
+	r := rand.New(rand.NewSource(42))
+
+	qt := New(orb.Bound{Min: orb.Point{0, 0}, Max: orb.Point{1, 1}})
+	for i := 0; i < 1000; i++ {
+		qt.Add(orb.Point{r.Float64(), r.Float64()})
+	}
+
+	calls := 0
+	df := func(a, b orb.Point) float64 {
+		calls++
+		return planar.Distance(a, b)
+	}
+
+	for i := 0; i < 10; i++ {
+		p := orb.Point{r.Float64(), r.Float64()}
+
+		calls = 0
+		result := qt.KNearestDistance(nil, p, 5, df)
+		if calls > 200 {
+			t.Errorf("should prune the search: %d distance calls", calls)
+		}
+
+		expected := qt.KNearest(nil, p, 5)
+		for j := range expected {
+			if v := result[j].Point(); !v.Equal(expected[j].Point()) {
+				t.Errorf("incorrect point %d: %v != %v", j, v, expected[j].Point())
+			}
+		}
+	}
+}
+
+func TestQuadtreeKNearestWeighted(t *testing.T) {
+	type dataPointer struct {
+		orb.Pointer
+		rating float64
+	}
+
+	q := New(orb.Bound{Max: orb.Point{5, 5}})
+	q.Add(dataPointer{orb.Point{0, 0}, 1})
+	q.Add(dataPointer{orb.Point{1, 1}, 1})
+	q.Add(dataPointer{orb.Point{2, 2}, 1})
+	q.Add(dataPointer{orb.Point{4, 4}, 10})
+	q.Add(dataPointer{orb.Point{5, 5}, 1})
+
+	weight := func(p orb.Pointer, d float64) float64 {
+		return d / p.(dataPointer).rating
+	}
+
+	result := q.KNearestWeighted(nil, orb.Point{0, 0}, 3, weight)
+
+	expected := []orb.Point{{0, 0}, {4, 4}, {1, 1}}
+	if len(result) != len(expected) {
+		t.Fatalf("incorrect response length: %d != %d", len(result), len(expected))
+	}
+
+	for i, p := range expected {
+		if v := result[i].Point(); !v.Equal(p) {
+			t.Errorf("incorrect point %d: %v != %v", i, v, p)
+		}
+	}
+
+	// should match KNearest with a distance weight
+	r := rand.New(rand.NewSource(42))
+	qt := New(orb.Bound{Min: orb.Point{0, 0}, Max: orb.Point{1, 1}})
+	for i := 0; i < 1000; i++ {
+		qt.Add(orb.Point{r.Float64(), r.Float64()})
+	}
+
+	for i := 0; i < 100; i++ {
+		p := orb.Point{r.Float64(), r.Float64()}
+		nearest := qt.KNearest(nil, p, 5)
+		weighted := qt.KNearestWeighted(nil, p, 5, func(_ orb.Pointer, d float64) float64 { return d })
+
+		if !reflect.DeepEqual(nearest, weighted) {
+			t.Errorf("index %d: should match KNearest: %v != %v", i, weighted, nearest)
+		}
+	}
+
+	if v := q.KNearestWeighted(nil, orb.Point{}, 0, weight); len(v) != 0 {
+		t.Errorf("should return no results for k=0: %v", v)
+	}
+}
+
+func TestQuadtreeFindAll(t *testing.T) {
+	type dataPointer struct {
+		orb.Pointer
+		id int
+	}
+
+	q := New(orb.Bound{Max: orb.Point{5, 5}})
+	q.Add(dataPointer{orb.Point{1, 1}, 0})
+	q.Add(dataPointer{orb.Point{2, 2}, 1})
+	q.Add(dataPointer{orb.Point{2, 2}, 2})
+	q.Add(dataPointer{orb.Point{3, 3}, 3})
+	q.Add(dataPointer{orb.Point{2, 2}, 4})
+
+	ids := func(ps []orb.Pointer) []int {
+		result := []int{}
+		for _, p := range ps {
+			result = append(result, p.(dataPointer).id)
+		}
+		sort.Ints(result)
+		return result
+	}
+
+	if v := ids(q.FindAll(orb.Point{2, 2})); !reflect.DeepEqual(v, []int{1, 2, 4}) {
+		t.Errorf("should find all pointers at the point: %v", v)
+	}
+
+	if v := q.FindAll(orb.Point{2, 2.5}); len(v) != 0 {
+		t.Errorf("should find nothing: %v", v)
+	}
+
+	// remove a specific one of the duplicates
+	removed := q.Remove(orb.Point{2, 2}, func(p orb.Pointer) bool {
+		return p.(dataPointer).id == 2
+	})
+	if !removed {
+		t.Errorf("should remove pointer")
+	}
+
+	if v := ids(q.FindAll(orb.Point{2, 2})); !reflect.DeepEqual(v, []int{1, 4}) {
+		t.Errorf("should remove the specific pointer: %v", v)
+	}
+
+	if v := ids(q.FindAll(orb.Point{3, 3})); !reflect.DeepEqual(v, []int{3}) {
+		t.Errorf("should not affect other points: %v", v)
+	}
+
+	if v := New(q.Bound()).FindAll(orb.Point{2, 2}); len(v) != 0 {
+		t.Errorf("empty tree should find nothing: %v", v)
+	}
+}
+
+func TestQuadtreeKFarthest(t *testing.T) {
+	r := rand.New(rand.NewSource(42))
+
+	qt := New(orb.Bound{Min: orb.Point{0, 0}, Max: orb.Point{1, 1}})
+	mp := orb.MultiPoint{}
+	for i := 0; i < 1000; i++ {
+		mp = append(mp, orb.Point{r.Float64(), r.Float64()})
+		qt.Add(mp[i])
+	}
+
+	for i := 0; i < 100; i++ {
+		p := orb.Point{r.Float64(), r.Float64()}
+
+		sort.Slice(mp, func(i, j int) bool {
+			return planar.DistanceSquared(mp[i], p) > planar.DistanceSquared(mp[j], p)
+		})
+
+		result := qt.KFarthest(nil, p, 5)
+		if len(result) != 5 {
+			t.Fatalf("incorrect number of results: %d", len(result))
+		}
+
+		for j, v := range result {
+			if !v.Point().Equal(mp[j]) {
+				t.Errorf("%d: incorrect point %v != %v", j, v, mp[j])
+			}
+		}
+	}
+
+	// more than in the tree
+	small := New(orb.Bound{Min: orb.Point{0, 0}, Max: orb.Point{1, 1}})
+	small.Add(orb.Point{0.1, 0.1})
+	small.Add(orb.Point{0.9, 0.9})
+
+	result := small.KFarthest(make([]orb.Pointer, 0, 10), orb.Point{0, 0}, 5)
+	expected := []orb.Pointer{orb.Point{0.9, 0.9}, orb.Point{0.1, 0.1}}
+	if !reflect.DeepEqual(result, expected) {
+		t.Errorf("incorrect result: %v", result)
+	}
+
+	if v := small.KFarthest(nil, orb.Point{}, 0); v != nil {
+		t.Errorf("k = 0 should return nil: %v", v)
+	}
+
+	if v := New(orb.Bound{}).KFarthest(nil, orb.Point{}, 1); v != nil {
+		t.Errorf("empty tree should return nil: %v", v)
+	}
+}
+
+func TestQuadtreeEstimatedBytes(t *testing.T) {
+	qt := New(orb.Bound{Min: orb.Point{0, 0}, Max: orb.Point{1, 1}})
+
+	empty := qt.EstimatedBytes()
+	if empty == 0 {
+		t.Errorf("empty tree should have a size")
+	}
+
+	r := rand.New(rand.NewSource(42))
+	for i := 0; i < 100; i++ {
+		qt.Add(orb.Point{r.Float64(), r.Float64()})
+	}
+
+	size := qt.EstimatedBytes()
+	if expected := empty + 100*unsafe.Sizeof(node{}); size != expected {
+		t.Errorf("incorrect size: %v != %v", size, expected)
+	}
+}
+
+func TestQuadtreeDataBound(t *testing.T) {
+	qt := New(orb.Bound{Min: orb.Point{0, 0}, Max: orb.Point{10, 10}})
+	if b := qt.DataBound(); !b.IsEmpty() {
+		t.Errorf("empty tree should have an empty bound: %v", b)
+	}
+
+	qt.Add(orb.Point{2, 3})
+	if b := qt.DataBound(); !b.Equal(orb.Bound{Min: orb.Point{2, 3}, Max: orb.Point{2, 3}}) {
+		t.Errorf("incorrect bound: %v", b)
+	}
+
+	r := rand.New(rand.NewSource(42))
+	mp := orb.MultiPoint{{2, 3}}
+	for i := 0; i < 100; i++ {
+		p := orb.Point{1 + 5*r.Float64(), 2 + 3*r.Float64()}
+		mp = append(mp, p)
+		qt.Add(p)
+	}
+
+	if b := qt.DataBound(); !b.Equal(mp.Bound()) {
+		t.Errorf("incorrect bound: %v != %v", b, mp.Bound())
+	}
+
+	// removed values are not included
+	for _, p := range mp {
+		qt.RemoveLazy(p, nil)
+	}
+
+	if b := qt.DataBound(); !b.IsEmpty() {
+		t.Errorf("should be empty after removing all points: %v", b)
+	}
+}
+
+func TestQuadtreeDataBound_tracked(t *testing.T) {
+	qt := New(orb.Bound{Min: orb.Point{0, 0}, Max: orb.Point{10, 10}}, TrackDataBound(true))
+	if b := qt.DataBound(); !b.IsEmpty() {
+		t.Errorf("empty tree should have an empty bound: %v", b)
+	}
+
+	r := rand.New(rand.NewSource(42))
+	var mp orb.MultiPoint
+	for i := 0; i < 100; i++ {
+		p := orb.Point{1 + 5*r.Float64(), 2 + 3*r.Float64()}
+		mp = append(mp, p)
+		qt.Add(p)
+
+		if b := qt.DataBound(); !b.Equal(mp.Bound()) {
+			t.Fatalf("incorrect bound: %v != %v", b, mp.Bound())
+		}
+	}
+
+	// points outside the tree bound are not added
+	qt.Add(orb.Point{20, 20})
+	if b := qt.DataBound(); !b.Equal(mp.Bound()) {
+		t.Errorf("incorrect bound: %v != %v", b, mp.Bound())
+	}
+
+	// removing points, including those on the edge, shrinks the bound
+	for len(mp) > 50 {
+		i := r.Intn(len(mp))
+		if i%2 == 0 {
+			qt.Remove(mp[i], nil)
+		} else {
+			qt.RemoveLazy(mp[i], nil)
+		}
+		mp = append(mp[:i], mp[i+1:]...)
+
+		if b := qt.DataBound(); !b.Equal(mp.Bound()) {
+			t.Fatalf("incorrect bound after remove: %v != %v", b, mp.Bound())
+		}
+	}
+
+	qt.Compact()
+	if b := qt.DataBound(); !b.Equal(mp.Bound()) {
+		t.Errorf("incorrect bound after compact: %v != %v", b, mp.Bound())
+	}
+
+	for _, p := range mp {
+		qt.Remove(p, nil)
+	}
+
+	if b := qt.DataBound(); !b.IsEmpty() {
+		t.Errorf("should be empty after removing all points: %v", b)
+	}
+}
+
+func TestQuadtreeDensity(t *testing.T) {
+	qt := New(orb.Bound{Min: orb.Point{0, 0}, Max: orb.Point{10, 10}})
+	if d := qt.Density(orb.Point{1, 1}, 1); d != 0 {
+		t.Errorf("empty tree should have zero density: %v", d)
+	}
+
+	qt.Add(orb.Point{5, 5})
+	qt.Add(orb.Point{5, 6})
+	qt.Add(orb.Point{8, 5})
+
+	if d := qt.Density(orb.Point{5, 5}, 2); d != 1.5 {
+		t.Errorf("incorrect density: %v", d)
+	}
+
+	if d := qt.Density(orb.Point{5, 5}, 0); d != 0 {
+		t.Errorf("zero bandwidth should have zero density: %v", d)
+	}
+
+	// compare with brute force
+	r := rand.New(rand.NewSource(42))
+	var mp orb.MultiPoint
+	for i := 0; i < 1000; i++ {
+		p := orb.Point{10 * r.Float64(), 10 * r.Float64()}
+		mp = append(mp, p)
+		qt.Add(p)
+	}
+	mp = append(mp, orb.Point{5, 5}, orb.Point{5, 6}, orb.Point{8, 5})
+
+	for i := 0; i < 100; i++ {
+		p := orb.Point{10 * r.Float64(), 10 * r.Float64()}
+		bandwidth := 2 * r.Float64()
+
+		expected := 0.0
+		for _, q := range mp {
+			if d := planar.Distance(p, q); d < bandwidth {
+				expected += 1 - d/bandwidth
+			}
+		}
+
+		if d := qt.Density(p, bandwidth); math.Abs(d-expected) > 1e-9 {
+			t.Errorf("incorrect density at %v: %v != %v", p, d, expected)
+		}
+	}
+}
+
+func TestQuadtreeCountInBound(t *testing.T) {
+	r := rand.New(rand.NewSource(42))
+
+	qt := New(orb.Bound{Min: orb.Point{0, 0}, Max: orb.Point{1, 1}})
+	for i := 0; i < 1000; i++ {
+		qt.Add(orb.Point{r.Float64(), r.Float64()})
+	}
+
+	for i := 0; i < 100; i++ {
+		b := orb.Point{r.Float64(), r.Float64()}.Bound().Pad(0.1)
+
+		if v, e := qt.CountInBound(b, nil), len(qt.InBound(nil, b)); v != e {
+			t.Errorf("incorrect count: %d != %d", v, e)
+		}
+
+		filter := func(p orb.Pointer) bool { return p.Point()[0] > 0.5 }
+		if v, e := qt.CountInBound(b, filter), len(qt.InBoundMatching(nil, b, filter)); v != e {
+			t.Errorf("incorrect filtered count: %d != %d", v, e)
+		}
+	}
+
+	if v := New(orb.Bound{}).CountInBound(orb.Bound{}, nil); v != 0 {
+		t.Errorf("empty tree should have no points: %d", v)
+	}
+}
@@ -19,7 +19,7 @@ func ExampleResample() {
 	fmt.Println(ls)
 
 	// Output:
-	// [[0 0] [2.5 0] [5 0] [7.5 0] [10 0]]
+	// LINESTRING(0 0,2.5 0,5 0,7.5 0,10 0)
 }
 
 func ExampleToInterval() {
@@ -30,5 +30,5 @@ func ExampleToInterval() {
 	fmt.Println(ls)
 
 	// Output:
-	// [[0 0] [2 0] [4 0] [6 0] [8 0] [10 0]]
+	// LINESTRING(0 0,2 0,4 0,6 0,8 0,10 0)
 }
@@ -1,5 +1,18 @@
 package orb
 
+import (
+	"errors"
+	"math"
+)
+
+var (
+	// ErrRingTooShort is returned by Ring.Validate if the ring has fewer than 4 points.
+	ErrRingTooShort = errors.New("orb: ring has fewer than 4 points")
+
+	// ErrRingNotClosed is returned by Ring.Validate if the first and last points differ.
+	ErrRingNotClosed = errors.New("orb: ring is not closed")
+)
+
 // Ring represents a set of ring on the earth.
 type Ring LineString
 
@@ -20,12 +33,52 @@ func (r Ring) Closed() bool {
 	return (len(r) >= 4) && (r[0] == r[len(r)-1])
 }
 
+// Validate returns ErrRingTooShort if the ring has fewer than 4 points
+// or ErrRingNotClosed if the first and last points are not the same.
+// Returns nil if the ring is Closed.
+// NOTE: this will not check for self-intersection.
+func (r Ring) Validate() error {
+	if len(r) < 4 {
+		return ErrRingTooShort
+	}
+
+	if r[0] != r[len(r)-1] {
+		return ErrRingNotClosed
+	}
+
+	return nil
+}
+
+// MustClose returns the ring closed by appending the first point if needed.
+// It panics if the ring can not be closed because it would have fewer than
+// 4 points. The original ring is not modified but may share the same
+// underlying array if it is already closed.
+func (r Ring) MustClose() Ring {
+	if r.Closed() {
+		return r
+	}
+
+	if len(r) < 3 || r[0] == r[len(r)-1] {
+		panic(ErrRingTooShort)
+	}
+
+	closed := make(Ring, 0, len(r)+1)
+	closed = append(closed, r...)
+	return append(closed, r[0])
+}
+
 // Reverse changes the direction of the ring.
 // This is done inplace, ie. it modifies the original data.
 func (r Ring) Reverse() {
 	LineString(r).Reverse()
 }
 
+// Map returns a new ring with the function applied to each point.
+// The original ring is not modified.
+func (r Ring) Map(fn func(Point) Point) Ring {
+	return Ring(MultiPoint(r).Map(fn))
+}
+
 // Bound returns a rect around the ring. Uses rectangular coordinates.
 func (r Ring) Bound() Bound {
 	return MultiPoint(r).Bound()
@@ -58,12 +111,178 @@ func (r Ring) Orientation() Orientation {
 	return 0
 }
 
+// IsConvex returns true if the ring is convex, ie. all the turns are in
+// the same direction. Collinear and repeated points are allowed.
+// The ring does not need to be closed. Degenerate rings with no area
+// and self-intersecting rings return false.
+func (r Ring) IsConvex() bool {
+	// remove repeated points, including the closing point.
+	ps := make([]Point, 0, len(r))
+	for _, p := range r {
+		if len(ps) == 0 || ps[len(ps)-1] != p {
+			ps = append(ps, p)
+		}
+	}
+
+	if len(ps) > 1 && ps[0] == ps[len(ps)-1] {
+		ps = ps[:len(ps)-1]
+	}
+
+	if len(ps) < 3 {
+		return false
+	}
+
+	sign := 0.0
+	turning := 0.0
+	for i := range ps {
+		a := ps[i]
+		b := ps[(i+1)%len(ps)]
+		c := ps[(i+2)%len(ps)]
+
+		cross := (b[0]-a[0])*(c[1]-b[1]) - (b[1]-a[1])*(c[0]-b[0])
+		dot := (b[0]-a[0])*(c[0]-b[0]) + (b[1]-a[1])*(c[1]-b[1])
+		if cross == 0 {
+			if dot < 0 {
+				// the ring doubles back on itself.
+				return false
+			}
+			continue
+		}
+
+		if sign == 0 {
+			sign = cross
+		} else if (sign > 0) != (cross > 0) {
+			return false
+		}
+
+		turning += math.Atan2(cross, dot)
+	}
+
+	// no turns means all the points are collinear. A simple convex ring
+	// turns exactly once all the way around, self-intersecting ones more.
+	return sign != 0 && math.Abs(math.Abs(turning)-2*math.Pi) < 1e-6
+}
+
+// PointAtFraction returns the point at the given fraction of the perimeter,
+// measured from the first point along the ring. The fraction wraps around,
+// e.g. 1.25 is the same as 0.25 and -0.25 is the same as 0.75. Like Length,
+// the closing segment of a ring that is not closed is not included, so the
+// point is at f*Length(r) along the points as given. The perimeter is computed
+// using planar distance. Degenerate rings with no length return the first point.
+func (r Ring) PointAtFraction(f float64) Point {
+	if len(r) == 0 {
+		return Point{}
+	}
+
+	n := len(r)
+	total := 0.0
+	for i := 1; i < n; i++ {
+		total += planarDistance(r[i-1], r[i])
+	}
+
+	if total == 0 {
+		return r[0]
+	}
+
+	f -= math.Floor(f)
+	target := f * total
+
+	for i := 1; i < n; i++ {
+		a, b := r[i-1], r[i]
+
+		d := planarDistance(a, b)
+		if target <= d && d > 0 {
+			t := target / d
+			return Point{a[0] + t*(b[0]-a[0]), a[1] + t*(b[1]-a[1])}
+		}
+
+		target -= d
+	}
+
+	return r[0]
+}
+
+// Canonical returns a new closed ring starting at its smallest point, compared
+// by x and then y, with a counter-clockwise orientation. Rings with the same
+// shape but a different start or direction have equal canonical rings,
+// useful for comparing or deduplicating. Degenerate rings keep their direction.
+func (r Ring) Canonical() Ring {
+	if len(r) == 0 {
+		return nil
+	}
+
+	points := r
+	if len(points) > 1 && points[0] == points[len(points)-1] {
+		points = points[:len(points)-1]
+	}
+
+	result := make(Ring, 0, len(points)+1)
+	if r.Orientation() == CW {
+		for i := len(points) - 1; i >= 0; i-- {
+			result = append(result, points[i])
+		}
+	} else {
+		result = append(result, points...)
+	}
+
+	min := 0
+	for i, p := range result {
+		if p[0] < result[min][0] || (p[0] == result[min][0] && p[1] < result[min][1]) {
+			min = i
+		}
+	}
+
+	rotated := make(Ring, 0, len(points)+1)
+	rotated = append(rotated, result[min:]...)
+	rotated = append(rotated, result[:min]...)
+
+	return append(rotated, rotated[0])
+}
+
 // Equal compares two rings. Returns true if lengths are the same
 // and all points are Equal.
 func (r Ring) Equal(ring Ring) bool {
 	return MultiPoint(r).Equal(MultiPoint(ring))
 }
 
+// EqualUnoriented compares two rings ignoring their direction. Returns true
+// if the rings are Equal or if one is the reverse of the other starting at
+// the same point. For rings that are not closed the closing vertex is implied,
+// so [a, b, c] is the reverse of [a, c, b]. Use Canonical to also ignore
+// the starting point.
+func (r Ring) EqualUnoriented(ring Ring) bool {
+	if len(r) != len(ring) {
+		return false
+	}
+
+	if r.Equal(ring) {
+		return true
+	}
+
+	n := len(r)
+	if r[0] == r[n-1] {
+		for i := range r {
+			if r[i] != ring[n-1-i] {
+				return false
+			}
+		}
+
+		return true
+	}
+
+	if r[0] != ring[0] {
+		return false
+	}
+
+	for i := 1; i < n; i++ {
+		if r[i] != ring[n-i] {
+			return false
+		}
+	}
+
+	return true
+}
+
 // Clone returns a new copy of the ring.
 func (r Ring) Clone() Ring {
 	if r == nil {
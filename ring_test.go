@@ -1,6 +1,7 @@
 package orb
 
 import (
+	"errors"
 	"testing"
 )
 
@@ -51,6 +52,70 @@ func TestRing_Closed(t *testing.T) {
 	}
 }
 
+func TestRing_Validate(t *testing.T) {
+	cases := []struct {
+		name string
+		ring Ring
+		err  error
+	}{
+		{
+			name: "closed",
+			ring: Ring{{0, 0}, {3, 0}, {3, 4}, {0, 0}},
+			err:  nil,
+		},
+		{
+			name: "not closed",
+			ring: Ring{{0, 0}, {3, 0}, {3, 3}, {3, 4}},
+			err:  ErrRingNotClosed,
+		},
+		{
+			name: "empty ring",
+			ring: Ring{},
+			err:  ErrRingTooShort,
+		},
+		{
+			name: "three vertex ring",
+			ring: Ring{{3, 0}, {0, 0}, {3, 0}},
+			err:  ErrRingTooShort,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			if err := tc.ring.Validate(); !errors.Is(err, tc.err) {
+				t.Errorf("incorrect error: %v != %v", err, tc.err)
+			}
+		})
+	}
+}
+
+func TestRing_MustClose(t *testing.T) {
+	r := Ring{{0, 0}, {3, 0}, {3, 4}}
+	closed := r.MustClose()
+	if !closed.Equal(Ring{{0, 0}, {3, 0}, {3, 4}, {0, 0}}) {
+		t.Errorf("incorrect ring: %v", closed)
+	}
+
+	if len(r) != 3 {
+		t.Errorf("should not modify the original: %v", r)
+	}
+
+	if c := closed.MustClose(); !c.Equal(closed) {
+		t.Errorf("closed ring should not change: %v", c)
+	}
+
+	for _, r := range []Ring{nil, {{0, 0}, {1, 1}}, {{0, 0}, {1, 1}, {0, 0}}} {
+		func() {
+			defer func() {
+				if recover() == nil {
+					t.Errorf("should panic: %v", r)
+				}
+			}()
+			r.MustClose()
+		}()
+	}
+}
+
 func TestRing_Orientation(t *testing.T) {
 	cases := []struct {
 		name   string
@@ -85,3 +150,188 @@ func TestRing_Orientation(t *testing.T) {
 		})
 	}
 }
+
+func TestRingMap(t *testing.T) {
+	r := Ring{{0, 0}, {1, 0}, {1, 1}, {0, 0}}
+	result := r.Map(func(p Point) Point {
+		return Point{p[0] + 1, p[1] + 1}
+	})
+
+	if expected := (Ring{{1, 1}, {2, 1}, {2, 2}, {1, 1}}); !result.Equal(expected) {
+		t.Errorf("incorrect result: %v != %v", result, expected)
+	}
+
+	if !r.Equal(Ring{{0, 0}, {1, 0}, {1, 1}, {0, 0}}) {
+		t.Errorf("should not modify original: %v", r)
+	}
+}
+
+func TestRingIsConvex(t *testing.T) {
+	cases := []struct {
+		name   string
+		ring   Ring
+		result bool
+	}{
+		{
+			name:   "square",
+			ring:   Ring{{0, 0}, {1, 0}, {1, 1}, {0, 1}, {0, 0}},
+			result: true,
+		},
+		{
+			name:   "clockwise square",
+			ring:   Ring{{0, 0}, {0, 1}, {1, 1}, {1, 0}, {0, 0}},
+			result: true,
+		},
+		{
+			name:   "not closed",
+			ring:   Ring{{0, 0}, {1, 0}, {1, 1}, {0, 1}},
+			result: true,
+		},
+		{
+			name:   "collinear point",
+			ring:   Ring{{0, 0}, {1, 0}, {2, 0}, {2, 2}, {0, 2}, {0, 0}},
+			result: true,
+		},
+		{
+			name:   "repeated point",
+			ring:   Ring{{0, 0}, {1, 0}, {1, 0}, {1, 1}, {0, 0}},
+			result: true,
+		},
+		{
+			name:   "concave",
+			ring:   Ring{{0, 0}, {4, 0}, {4, 4}, {2, 1}, {0, 4}, {0, 0}},
+			result: false,
+		},
+		{
+			name:   "pentagram",
+			ring:   Ring{{0, 0}, {2, 6}, {4, 0}, {-1, 4}, {5, 4}, {0, 0}},
+			result: false,
+		},
+		{
+			name:   "line",
+			ring:   Ring{{0, 0}, {1, 0}, {2, 0}, {0, 0}},
+			result: false,
+		},
+		{
+			name:   "too few points",
+			ring:   Ring{{0, 0}, {1, 1}, {0, 0}},
+			result: false,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			if v := tc.ring.IsConvex(); v != tc.result {
+				t.Errorf("incorrect result: %v != %v", v, tc.result)
+			}
+		})
+	}
+}
+
+func TestRingPointAtFraction(t *testing.T) {
+	square := Ring{{0, 0}, {2, 0}, {2, 2}, {0, 2}, {0, 0}}
+
+	cases := []struct {
+		name     string
+		ring     Ring
+		fraction float64
+		expected Point
+	}{
+		{name: "start", ring: square, fraction: 0, expected: Point{0, 0}},
+		{name: "first side", ring: square, fraction: 0.125, expected: Point{1, 0}},
+		{name: "corner", ring: square, fraction: 0.25, expected: Point{2, 0}},
+		{name: "third side", ring: square, fraction: 0.625, expected: Point{1, 2}},
+		{name: "end wraps", ring: square, fraction: 1, expected: Point{0, 0}},
+		{name: "wraps", ring: square, fraction: 1.125, expected: Point{1, 0}},
+		{name: "negative", ring: square, fraction: -0.125, expected: Point{0, 1}},
+		{name: "not closed", ring: square[:4], fraction: 0.5, expected: Point{2, 1}},
+		{name: "not closed end", ring: square[:4], fraction: 0.75, expected: Point{1.5, 2}},
+		{name: "repeated point", ring: Ring{{0, 0}, {0, 0}, {2, 0}, {0, 0}}, fraction: 0.25, expected: Point{1, 0}},
+		{name: "degenerate", ring: Ring{{1, 1}, {1, 1}}, fraction: 0.5, expected: Point{1, 1}},
+		{name: "empty", ring: Ring{}, fraction: 0.5, expected: Point{}},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			if v := tc.ring.PointAtFraction(tc.fraction); !v.Equal(tc.expected) {
+				t.Errorf("incorrect point: %v != %v", v, tc.expected)
+			}
+		})
+	}
+}
+
+func TestRingCanonical(t *testing.T) {
+	expected := Ring{{0, 0}, {1, 0}, {1, 1}, {0, 1}, {0, 0}}
+
+	cases := []struct {
+		name string
+		ring Ring
+	}{
+		{name: "already canonical", ring: Ring{{0, 0}, {1, 0}, {1, 1}, {0, 1}, {0, 0}}},
+		{name: "rotated", ring: Ring{{1, 1}, {0, 1}, {0, 0}, {1, 0}, {1, 1}}},
+		{name: "clockwise", ring: Ring{{1, 0}, {0, 0}, {0, 1}, {1, 1}, {1, 0}}},
+		{name: "not closed", ring: Ring{{1, 0}, {1, 1}, {0, 1}, {0, 0}}},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			original := tc.ring.Clone()
+			if v := tc.ring.Canonical(); !v.Equal(expected) {
+				t.Errorf("incorrect ring: %v != %v", v, expected)
+			}
+
+			if !tc.ring.Equal(original) {
+				t.Errorf("should not modify the ring: %v", tc.ring)
+			}
+		})
+	}
+
+	if v := (Ring{}).Canonical(); v != nil {
+		t.Errorf("empty ring should return nil: %v", v)
+	}
+
+	if v := (Ring{{1, 2}}).Canonical(); !v.Equal(Ring{{1, 2}, {1, 2}}) {
+		t.Errorf("single point should be closed: %v", v)
+	}
+}
+
+func TestRingEqualUnoriented(t *testing.T) {
+	r := Ring{{0, 0}, {1, 0}, {1, 1}, {0, 1}, {0, 0}}
+
+	cases := []struct {
+		name     string
+		ring     Ring
+		expected bool
+	}{
+		{name: "same", ring: Ring{{0, 0}, {1, 0}, {1, 1}, {0, 1}, {0, 0}}, expected: true},
+		{name: "reversed", ring: Ring{{0, 0}, {0, 1}, {1, 1}, {1, 0}, {0, 0}}, expected: true},
+		{name: "different", ring: Ring{{0, 0}, {0, 1}, {1, 2}, {1, 0}, {0, 0}}, expected: false},
+		{name: "different start", ring: Ring{{1, 0}, {1, 1}, {0, 1}, {0, 0}, {1, 0}}, expected: false},
+		{name: "different length", ring: Ring{{0, 0}, {1, 0}, {1, 1}, {0, 0}}, expected: false},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			if v := r.EqualUnoriented(tc.ring); v != tc.expected {
+				t.Errorf("incorrect result: %v != %v", v, tc.expected)
+			}
+
+			if v := tc.ring.EqualUnoriented(r); v != tc.expected {
+				t.Errorf("should be symmetric: %v != %v", v, tc.expected)
+			}
+		})
+	}
+
+	open := Ring{{0, 0}, {1, 0}, {1, 1}}
+	if !open.EqualUnoriented(Ring{{0, 0}, {1, 1}, {1, 0}}) {
+		t.Errorf("open rings should match their reverse")
+	}
+
+	if open.EqualUnoriented(Ring{{1, 1}, {1, 0}, {0, 0}}) {
+		t.Errorf("open rings should start at the same point")
+	}
+
+	if !(Ring{}).EqualUnoriented(nil) {
+		t.Errorf("empty rings should be equal")
+	}
+}
@@ -83,6 +83,59 @@ func dpWorker(ls orb.LineString, threshold float64, mask []byte) int {
 	return found
 }
 
+// DouglasPeuckerIndexed simplifies the line string using the given threshold
+// and returns the indexes of the kept points along with the simplified line.
+// Unlike the simplifiers the input is not modified, so it can be simplified
+// multiple times, e.g. for different levels of detail sharing the same vertices.
+func DouglasPeuckerIndexed(ls orb.LineString, threshold float64) ([]int, orb.LineString) {
+	simplified, kept := runSimplifyWithIndexes(DouglasPeucker(threshold), ls.Clone())
+	return kept, simplified
+}
+
+// DouglasPeuckerKeep simplifies the line string using the given threshold
+// but never removes the points for which keep returns true, e.g. the stops
+// in a GPS trace. The endpoints are always kept. The line is simplified
+// independently between the kept points. The input is not modified.
+func DouglasPeuckerKeep(ls orb.LineString, threshold float64, keep func(i int) bool) orb.LineString {
+	if len(ls) <= 2 {
+		return ls.Clone()
+	}
+
+	mask := make([]byte, len(ls))
+	mask[0] = 1
+	mask[len(mask)-1] = 1
+	for i := 1; i < len(ls)-1; i++ {
+		if keep(i) {
+			mask[i] = 1
+		}
+	}
+
+	start := 0
+	for i := 1; i < len(ls); i++ {
+		if mask[i] == 1 {
+			dpWorker(ls[start:i+1], threshold, mask[start:i+1])
+			start = i
+		}
+	}
+
+	result := make(orb.LineString, 0, len(ls))
+	for i, v := range mask {
+		if v == 1 {
+			result = append(result, ls[i])
+		}
+	}
+
+	return result
+}
+
+// Geometry simplifies any geometry using Douglas-Peucker with the given
+// epsilon. Multi-line string components, polygon rings, multi-polygon parts
+// and collection members are simplified individually, points are returned
+// as is. Unlike the simplifiers the input is not modified.
+func Geometry(g orb.Geometry, epsilon float64) orb.Geometry {
+	return DouglasPeucker(epsilon).Simplify(orb.Clone(g))
+}
+
 // Simplify will run the simplification for any geometry type.
 func (s *DouglasPeuckerSimplifier) Simplify(g orb.Geometry) orb.Geometry {
 	return simplify(s, g)
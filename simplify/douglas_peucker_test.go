@@ -55,3 +55,128 @@ func TestDouglasPeucker(t *testing.T) {
 		})
 	}
 }
+
+func TestDouglasPeuckerIndexed(t *testing.T) {
+	ls := orb.LineString{{0, 0}, {1, 0.52}, {2, 1}, {3, 0.5}, {4, 0.25}, {5, 0}}
+	original := ls.Clone()
+
+	kept, simplified := DouglasPeuckerIndexed(ls, 0.1)
+	if !reflect.DeepEqual(kept, []int{0, 2, 3, 5}) {
+		t.Errorf("incorrect kept indexes: %v", kept)
+	}
+
+	for i, k := range kept {
+		if !simplified[i].Equal(ls[k]) {
+			t.Errorf("index %d does not match point: %v != %v", k, simplified[i], ls[k])
+		}
+	}
+
+	if !ls.Equal(original) {
+		t.Errorf("should not modify the input: %v", ls)
+	}
+
+	// larger thresholds keep a subset of the vertices
+	coarse, _ := DouglasPeuckerIndexed(ls, 2)
+	if !reflect.DeepEqual(coarse, []int{0, 5}) {
+		t.Errorf("incorrect coarse indexes: %v", coarse)
+	}
+
+	kept, simplified = DouglasPeuckerIndexed(orb.LineString{{1, 1}}, 1)
+	if !reflect.DeepEqual(kept, []int{0}) || len(simplified) != 1 {
+		t.Errorf("single point should be kept: %v %v", kept, simplified)
+	}
+}
+
+func TestDouglasPeuckerKeep(t *testing.T) {
+	ls := orb.LineString{{0, 0}, {1, 0.01}, {2, 0}, {3, 0.01}, {4, 0}, {5, 1}, {6, 0}}
+	original := ls.Clone()
+
+	// without keeping anything it's the same as the regular simplify
+	v := DouglasPeuckerKeep(ls, 0.1, func(int) bool { return false })
+	expected := DouglasPeucker(0.1).LineString(ls.Clone())
+	if !v.Equal(expected) {
+		t.Errorf("incorrect line: %v != %v", v, expected)
+	}
+
+	v = DouglasPeuckerKeep(ls, 0.1, func(i int) bool { return i == 1 || i == 3 })
+	expected = orb.LineString{{0, 0}, {1, 0.01}, {3, 0.01}, {4, 0}, {5, 1}, {6, 0}}
+	if !v.Equal(expected) {
+		t.Errorf("incorrect line: %v != %v", v, expected)
+	}
+
+	if !ls.Equal(original) {
+		t.Errorf("should not modify the input: %v", ls)
+	}
+
+	// endpoints are always kept
+	v = DouglasPeuckerKeep(orb.LineString{{0, 0}, {1, 0}, {2, 0}}, 1, func(int) bool { return false })
+	expected = orb.LineString{{0, 0}, {2, 0}}
+	if !v.Equal(expected) {
+		t.Errorf("incorrect line: %v != %v", v, expected)
+	}
+}
+
+func TestGeometry(t *testing.T) {
+	ls := orb.LineString{{0, 0}, {1, 0.1}, {2, 0}, {3, 5}, {4, 0}}
+	simplified := orb.LineString{{0, 0}, {2, 0}, {3, 5}, {4, 0}}
+
+	ring := orb.Ring{{0, 0}, {1, 0.1}, {2, 0}, {2, 2}, {0, 2}, {0, 0}}
+	simplifiedRing := orb.Ring{{0, 0}, {2, 0}, {2, 2}, {0, 2}, {0, 0}}
+
+	cases := []struct {
+		name     string
+		geom     orb.Geometry
+		expected orb.Geometry
+	}{
+		{
+			name:     "point",
+			geom:     orb.Point{1, 2},
+			expected: orb.Point{1, 2},
+		},
+		{
+			name:     "multi point",
+			geom:     orb.MultiPoint{{0, 0}, {1, 0.1}, {2, 0}},
+			expected: orb.MultiPoint{{0, 0}, {1, 0.1}, {2, 0}},
+		},
+		{
+			name:     "line string",
+			geom:     ls,
+			expected: simplified,
+		},
+		{
+			name:     "multi line string",
+			geom:     orb.MultiLineString{ls, ls},
+			expected: orb.MultiLineString{simplified, simplified},
+		},
+		{
+			name:     "polygon",
+			geom:     orb.Polygon{ring},
+			expected: orb.Polygon{simplifiedRing},
+		},
+		{
+			name:     "multi polygon",
+			geom:     orb.MultiPolygon{{ring}, {ring}},
+			expected: orb.MultiPolygon{{simplifiedRing}, {simplifiedRing}},
+		},
+		{
+			name:     "collection",
+			geom:     orb.Collection{orb.Point{1, 2}, ls, orb.Collection{orb.Polygon{ring}}},
+			expected: orb.Collection{orb.Point{1, 2}, simplified, orb.Collection{orb.Polygon{simplifiedRing}}},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			original := orb.Clone(tc.geom)
+
+			result := Geometry(tc.geom, 0.5)
+			if !orb.Equal(result, tc.expected) {
+				t.Errorf("incorrect geometry: %v != %v", result, tc.expected)
+			}
+
+			if !orb.Equal(tc.geom, original) {
+				t.Errorf("should not modify the input: %v", tc.geom)
+			}
+		})
+	}
+}
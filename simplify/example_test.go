@@ -27,8 +27,8 @@ func ExampleDouglasPeuckerSimplifier() {
 	fmt.Println(reduced)
 
 	// Output:
-	// [[0 0] [2 0] [0 2]]
-	// [[0 0] [0 2]]
+	// LINESTRING(0 0,2 0,0 2)
+	// LINESTRING(0 0,0 2)
 }
 
 func ExampleRadialSimplifier() {
@@ -0,0 +1,233 @@
+package simplify
+
+import (
+	"math"
+
+	"github.com/paulmach/orb"
+)
+
+// Topology simplifies the polygons using Douglas-Peucker while preserving the
+// borders shared between them. The rings are split into arcs at the junctions
+// where borders meet, every unique arc is simplified once and the rings are
+// reassembled from the simplified arcs, so adjacent polygons stay coincident
+// and no gaps or overlaps are created. The input is not modified and
+// the result is aligned by index with the input. Holes that collapse are
+// removed, polygons whose outer ring collapses are nil.
+func Topology(polygons []orb.Polygon, epsilon float64) []orb.Polygon {
+	// remove repeated and closing points, the rings are treated as cyclic
+	rings := make([][][]orb.Point, len(polygons))
+	for i, p := range polygons {
+		rings[i] = make([][]orb.Point, len(p))
+		for j, r := range p {
+			rings[i][j] = openRing(r)
+		}
+	}
+
+	junctions := findJunctions(rings)
+
+	t := &topology{
+		simplifier: DouglasPeucker(epsilon),
+		lookup:     make(map[string]int),
+	}
+
+	result := make([]orb.Polygon, len(polygons))
+	for i := range rings {
+		p := make(orb.Polygon, 0, len(rings[i]))
+		for j, r := range rings[i] {
+			ring := t.ring(r, junctions)
+			if len(ring) < 4 {
+				if j == 0 {
+					break
+				}
+				continue
+			}
+
+			p = append(p, ring)
+		}
+
+		if len(p) > 0 {
+			result[i] = p
+		}
+	}
+
+	return result
+}
+
+type topology struct {
+	simplifier *DouglasPeuckerSimplifier
+
+	// the simplified arcs in their canonical direction
+	arcs   []orb.LineString
+	lookup map[string]int
+}
+
+// ring splits the ring into arcs and reassembles it
+// from the simplified version of those arcs.
+func (t *topology) ring(r []orb.Point, junctions map[orb.Point]bool) orb.Ring {
+	if len(r) < 3 {
+		return nil
+	}
+
+	// rotate so the ring starts at a junction, if there are none start at the
+	// smallest point so rings that are the same start at the same place.
+	start := -1
+	for i, p := range r {
+		if junctions[p] {
+			start = i
+			break
+		}
+	}
+
+	if start == -1 {
+		start = 0
+		for i, p := range r {
+			if less(p, r[start]) {
+				start = i
+			}
+		}
+	}
+
+	var result orb.Ring
+	arc := orb.LineString{r[start]}
+	for i := 1; i <= len(r); i++ {
+		p := r[(start+i)%len(r)]
+		arc = append(arc, p)
+
+		if i == len(r) || junctions[p] {
+			simplified := t.arc(arc)
+			if len(result) == 0 {
+				result = append(result, simplified...)
+			} else {
+				result = append(result, simplified[1:]...)
+			}
+
+			arc = orb.LineString{p}
+		}
+	}
+
+	// start at the original first point if it was kept
+	for i := 1; i < len(result)-1; i++ {
+		if result[i] == r[0] {
+			rotated := make(orb.Ring, 0, len(result))
+			rotated = append(rotated, result[i:]...)
+			rotated = append(rotated, result[1:i+1]...)
+			return rotated
+		}
+	}
+
+	return result
+}
+
+// arc returns the simplified version of the arc in the direction given.
+// Every unique arc is simplified once, in a canonical direction,
+// so shared arcs are simplified the same way for every ring.
+func (t *topology) arc(arc orb.LineString) orb.LineString {
+	forward := arcKey(arc, false)
+	backward := arcKey(arc, true)
+
+	key := forward
+	reversed := false
+	if backward < forward {
+		key = backward
+		reversed = true
+	}
+
+	index, ok := t.lookup[key]
+	if !ok {
+		ls := arc.Clone()
+		if reversed {
+			ls.Reverse()
+		}
+
+		index = len(t.arcs)
+		t.lookup[key] = index
+		t.arcs = append(t.arcs, runSimplify(t.simplifier, ls))
+	}
+
+	result := t.arcs[index]
+	if reversed {
+		result = result.Clone()
+		result.Reverse()
+	}
+
+	return result
+}
+
+// findJunctions returns the points where borders meet, ie. points
+// with more than two distinct neighbors across all the rings.
+func findJunctions(rings [][][]orb.Point) map[orb.Point]bool {
+	neighbors := make(map[orb.Point][]orb.Point)
+	add := func(p, n orb.Point) {
+		for _, v := range neighbors[p] {
+			if v == n {
+				return
+			}
+		}
+		neighbors[p] = append(neighbors[p], n)
+	}
+
+	for _, polygon := range rings {
+		for _, r := range polygon {
+			for i, p := range r {
+				add(p, r[(i+len(r)-1)%len(r)])
+				add(p, r[(i+1)%len(r)])
+			}
+		}
+	}
+
+	junctions := make(map[orb.Point]bool)
+	for p, n := range neighbors {
+		if len(n) > 2 {
+			junctions[p] = true
+		}
+	}
+
+	return junctions
+}
+
+// openRing returns the points of the ring without repeated
+// consecutive points and without the closing point.
+func openRing(r orb.Ring) []orb.Point {
+	result := make([]orb.Point, 0, len(r))
+	for _, p := range r {
+		if len(result) == 0 || result[len(result)-1] != p {
+			result = append(result, p)
+		}
+	}
+
+	for len(result) > 1 && result[0] == result[len(result)-1] {
+		result = result[:len(result)-1]
+	}
+
+	return result
+}
+
+func arcKey(arc orb.LineString, reverse bool) string {
+	buf := make([]byte, 0, 16*len(arc))
+	for i := range arc {
+		p := arc[i]
+		if reverse {
+			p = arc[len(arc)-1-i]
+		}
+
+		buf = appendUint64(buf, math.Float64bits(p[0]))
+		buf = appendUint64(buf, math.Float64bits(p[1]))
+	}
+
+	return string(buf)
+}
+
+func appendUint64(buf []byte, v uint64) []byte {
+	return append(buf,
+		byte(v>>56), byte(v>>48), byte(v>>40), byte(v>>32),
+		byte(v>>24), byte(v>>16), byte(v>>8), byte(v),
+	)
+}
+
+func less(a, b orb.Point) bool {
+	if a[0] != b[0] {
+		return a[0] < b[0]
+	}
+
+	return a[1] < b[1]
+}
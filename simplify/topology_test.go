@@ -0,0 +1,115 @@
+package simplify
+
+import (
+	"testing"
+
+	"github.com/paulmach/orb"
+)
+
+func TestTopology(t *testing.T) {
+	// the shared border wiggles between (10, 0) and (10, 10)
+	border := []orb.Point{{10, 0}, {10.3, 2}, {9.8, 4}, {10.2, 6}, {9.9, 8}, {10, 10}}
+
+	left := orb.Ring{{0, 0}}
+	left = append(left, border...)
+	left = append(left, orb.Point{5, 10.2}, orb.Point{0, 10}, orb.Point{0, 0})
+
+	right := orb.Ring{{10, 10}}
+	for i := len(border) - 2; i >= 0; i-- {
+		right = append(right, border[i])
+	}
+	right = append(right, orb.Point{20, 0}, orb.Point{20, 10}, orb.Point{10, 10})
+
+	input := []orb.Polygon{{left}, {right}}
+	original := []orb.Polygon{input[0].Clone(), input[1].Clone()}
+
+	result := Topology(input, 1)
+	if len(result) != 2 {
+		t.Fatalf("incorrect number of polygons: %v", result)
+	}
+
+	if !input[0].Equal(original[0]) || !input[1].Equal(original[1]) {
+		t.Errorf("should not modify the input")
+	}
+
+	expectedLeft := orb.Ring{{0, 0}, {10, 0}, {10, 10}, {0, 10}, {0, 0}}
+	if !result[0][0].Equal(expectedLeft) {
+		t.Errorf("incorrect left: %v", result[0][0])
+	}
+
+	expectedRight := orb.Ring{{10, 10}, {10, 0}, {20, 0}, {20, 10}, {10, 10}}
+	if !result[1][0].Equal(expectedRight) {
+		t.Errorf("incorrect right: %v", result[1][0])
+	}
+
+	// a smaller epsilon keeps some of the border, it must be the same in both
+	result = Topology(input, 0.25)
+	shared := func(r orb.Ring) []orb.Point {
+		var points []orb.Point
+		for _, p := range r[:len(r)-1] {
+			if p[0] > 9 && p[0] < 11 {
+				points = append(points, p)
+			}
+		}
+		return points
+	}
+
+	l := shared(result[0][0])
+	r := shared(result[1][0])
+	if len(l) <= 2 || len(l) != len(r) {
+		t.Fatalf("borders not coincident: %v != %v", l, r)
+	}
+
+	for _, p := range l {
+		found := false
+		for _, q := range r {
+			found = found || p == q
+		}
+
+		if !found {
+			t.Errorf("point %v not in both borders: %v != %v", p, l, r)
+		}
+	}
+}
+
+func TestTopology_island(t *testing.T) {
+	island := orb.Ring{{4, 4}, {5, 4.1}, {6, 4}, {6, 6}, {4, 6}, {4, 4}}
+	hole := orb.Ring{{4, 4}, {4, 6}, {6, 6}, {6, 4}, {5, 4.1}, {4, 4}}
+
+	input := []orb.Polygon{
+		{{{0, 0}, {10, 0}, {10, 10}, {0, 10}, {0, 0}}, hole},
+		{island},
+	}
+
+	result := Topology(input, 0.5)
+
+	expected := orb.Ring{{4, 4}, {6, 4}, {6, 6}, {4, 6}, {4, 4}}
+	if !result[1][0].Equal(expected) {
+		t.Errorf("incorrect island: %v", result[1][0])
+	}
+
+	reversed := expected.Clone()
+	reversed.Reverse()
+	if len(result[0]) != 2 || !result[0][1].Equal(reversed) {
+		t.Errorf("hole should match the island: %v", result[0])
+	}
+}
+
+func TestTopology_collapse(t *testing.T) {
+	input := []orb.Polygon{
+		{
+			{{0, 0}, {10, 0}, {10, 10}, {0, 10}, {0, 0}},
+			{{1, 1}, {1.1, 1}, {1, 1.1}, {1, 1}},
+		},
+		{{{20, 20}, {20.1, 20}, {20, 20.1}, {20, 20}}},
+	}
+
+	result := Topology(input, 1)
+	if len(result[0]) != 1 {
+		t.Errorf("collapsed hole should be removed: %v", result[0])
+	}
+
+	if result[1] != nil {
+		t.Errorf("collapsed polygon should be nil: %v", result[1])
+	}
+}
@@ -0,0 +1,70 @@
+package spatialhash
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/paulmach/orb"
+	"github.com/paulmach/orb/quadtree"
+)
+
+func BenchmarkInsert(b *testing.B) {
+	r := rand.New(rand.NewSource(22))
+	h := New(0.01)
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		h.Insert(orb.Point{r.Float64(), r.Float64()})
+	}
+}
+
+func BenchmarkNear1000(b *testing.B) {
+	r := rand.New(rand.NewSource(42))
+	h := New(0.05)
+
+	for i := 0; i < 1000; i++ {
+		h.Insert(orb.Point{r.Float64(), r.Float64()})
+	}
+
+	var buf []orb.Pointer
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		buf = h.Near(buf, orb.Point{r.Float64(), r.Float64()}, 0.05)
+	}
+}
+
+func BenchmarkInBound1000(b *testing.B) {
+	r := rand.New(rand.NewSource(42))
+	h := New(0.05)
+
+	for i := 0; i < 1000; i++ {
+		h.Insert(orb.Point{r.Float64(), r.Float64()})
+	}
+
+	var buf []orb.Pointer
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		p := orb.Point{r.Float64(), r.Float64()}
+		buf = h.InBound(buf, orb.Bound{Min: p, Max: p}.Pad(0.05))
+	}
+}
+
+func BenchmarkInBound1000Quadtree(b *testing.B) {
+	r := rand.New(rand.NewSource(42))
+	qt := quadtree.New(orb.Bound{Min: orb.Point{0, 0}, Max: orb.Point{1, 1}})
+
+	for i := 0; i < 1000; i++ {
+		qt.Add(orb.Point{r.Float64(), r.Float64()})
+	}
+
+	var buf []orb.Pointer
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		p := orb.Point{r.Float64(), r.Float64()}
+		buf = qt.InBound(buf, orb.Bound{Min: p, Max: p}.Pad(0.05))
+	}
+}
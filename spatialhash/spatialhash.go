@@ -0,0 +1,139 @@
+// Package spatialhash implements a spatial hash, an index that buckets
+// points into square grid cells of a fixed size. For uniformly distributed
+// data it is simpler and often faster than a quadtree, but queries slow down
+// when many points end up in the same cell.
+package spatialhash
+
+import (
+	"math"
+
+	"github.com/paulmach/orb"
+	"github.com/paulmach/orb/planar"
+)
+
+// SpatialHash is an index of orb.Pointers bucketed by the grid cell,
+// aligned to the origin, that contains their point.
+type SpatialHash struct {
+	cellSize float64
+	cells    map[cellKey][]orb.Pointer
+	count    int
+}
+
+type cellKey struct {
+	x, y int64
+}
+
+// New creates a new spatial hash with square cells of the given size.
+// A good cell size is around the typical query radius. It will panic
+// if the cell size is not positive.
+func New(cellSize float64) *SpatialHash {
+	if !(cellSize > 0) {
+		panic("spatialhash: cell size must be positive")
+	}
+
+	return &SpatialHash{
+		cellSize: cellSize,
+		cells:    make(map[cellKey][]orb.Pointer),
+	}
+}
+
+// CellSize returns the size of the cells used by the spatial hash.
+func (h *SpatialHash) CellSize() float64 {
+	return h.cellSize
+}
+
+// Len returns the number of pointers in the spatial hash.
+func (h *SpatialHash) Len() int {
+	return h.count
+}
+
+// Insert adds the pointer to the spatial hash. Multiple pointers can be
+// inserted at the same point. This function is not thread-safe, ie. multiple
+// goroutines cannot insert into a single spatial hash.
+func (h *SpatialHash) Insert(p orb.Pointer) {
+	if p == nil {
+		return
+	}
+
+	key := h.key(p.Point())
+	h.cells[key] = append(h.cells[key], p)
+	h.count++
+}
+
+// InBound returns a slice with all the pointers in the spatial hash that are
+// within the given bound, the same as the quadtree. An optional buffer
+// parameter is provided to allow for the reuse of result slice memory.
+// This function is thread safe. Multiple goroutines can read from
+// a pre-created spatial hash.
+func (h *SpatialHash) InBound(buf []orb.Pointer, b orb.Bound) []orb.Pointer {
+	return h.inBound(buf, b, func(p orb.Pointer) bool {
+		return b.Contains(p.Point())
+	})
+}
+
+// Near returns a slice with all the pointers in the spatial hash within the
+// radius, using planar distance, of the point. An optional buffer parameter
+// is provided to allow for the reuse of result slice memory.
+// This function is thread safe. Multiple goroutines can read from
+// a pre-created spatial hash.
+func (h *SpatialHash) Near(buf []orb.Pointer, p orb.Point, radius float64) []orb.Pointer {
+	if radius < 0 {
+		return buf[:0]
+	}
+
+	b := orb.Bound{Min: p, Max: p}.Pad(radius)
+	r2 := radius * radius
+
+	return h.inBound(buf, b, func(pointer orb.Pointer) bool {
+		return planar.DistanceSquared(p, pointer.Point()) <= r2
+	})
+}
+
+// inBound calls the filter for every pointer in the cells covering the bound.
+func (h *SpatialHash) inBound(buf []orb.Pointer, b orb.Bound, f func(orb.Pointer) bool) []orb.Pointer {
+	result := buf[:0]
+	if b.IsEmpty() || h.count == 0 {
+		return result
+	}
+
+	// the cell range is kept as floats so huge or infinite bounds do not overflow.
+	minX := math.Floor(b.Min[0] / h.cellSize)
+	minY := math.Floor(b.Min[1] / h.cellSize)
+	maxX := math.Floor(b.Max[0] / h.cellSize)
+	maxY := math.Floor(b.Max[1] / h.cellSize)
+
+	add := func(pointers []orb.Pointer) {
+		for _, p := range pointers {
+			if f(p) {
+				result = append(result, p)
+			}
+		}
+	}
+
+	// if the bound covers more cells than are used it's faster to check them all.
+	if (maxX-minX+1)*(maxY-minY+1) > float64(len(h.cells)) {
+		for k, pointers := range h.cells {
+			x, y := float64(k.x), float64(k.y)
+			if x >= minX && x <= maxX && y >= minY && y <= maxY {
+				add(pointers)
+			}
+		}
+
+		return result
+	}
+
+	for x := int64(minX); x <= int64(maxX); x++ {
+		for y := int64(minY); y <= int64(maxY); y++ {
+			add(h.cells[cellKey{x: x, y: y}])
+		}
+	}
+
+	return result
+}
+
+func (h *SpatialHash) key(p orb.Point) cellKey {
+	return cellKey{
+		x: int64(math.Floor(p[0] / h.cellSize)),
+		y: int64(math.Floor(p[1] / h.cellSize)),
+	}
+}
@@ -0,0 +1,152 @@
+package spatialhash
+
+import (
+	"math"
+	"math/rand"
+	"sort"
+	"testing"
+
+	"github.com/paulmach/orb"
+	"github.com/paulmach/orb/planar"
+	"github.com/paulmach/orb/quadtree"
+)
+
+func TestNew(t *testing.T) {
+	h := New(0.5)
+	if v := h.CellSize(); v != 0.5 {
+		t.Errorf("incorrect cell size: %v", v)
+	}
+
+	for _, size := range []float64{0, -1, math.NaN()} {
+		func() {
+			defer func() {
+				if recover() == nil {
+					t.Errorf("should panic for cell size: %v", size)
+				}
+			}()
+			New(size)
+		}()
+	}
+}
+
+func TestSpatialHashInsert(t *testing.T) {
+	h := New(1)
+	h.Insert(orb.Point{0.5, 0.5})
+	h.Insert(orb.Point{0.5, 0.5})
+	h.Insert(orb.Point{-0.5, 10})
+	h.Insert(nil)
+
+	if l := h.Len(); l != 3 {
+		t.Errorf("incorrect length: %d", l)
+	}
+
+	if c := len(h.cells); c != 2 {
+		t.Errorf("incorrect number of cells: %d", c)
+	}
+
+	if k := h.key(orb.Point{-0.5, 10}); k != (cellKey{x: -1, y: 10}) {
+		t.Errorf("incorrect key: %v", k)
+	}
+}
+
+func TestSpatialHashInBound(t *testing.T) {
+	r := rand.New(rand.NewSource(42))
+
+	h := New(0.05)
+	qt := quadtree.New(orb.Bound{Min: orb.Point{0, 0}, Max: orb.Point{1, 1}})
+	for i := 0; i < 1000; i++ {
+		p := orb.Point{r.Float64(), r.Float64()}
+		h.Insert(p)
+		qt.Add(p)
+	}
+
+	// points on the cell boundaries
+	for _, p := range []orb.Point{{0.5, 0.5}, {0.1, 0.3}, {0, 0}, {1, 1}} {
+		h.Insert(p)
+		qt.Add(p)
+	}
+
+	bounds := []orb.Bound{
+		{Min: orb.Point{0.5, 0.5}, Max: orb.Point{0.5, 0.5}},
+		{Min: orb.Point{0.1, 0.3}, Max: orb.Point{0.5, 0.5}},
+		{Min: orb.Point{-1, -1}, Max: orb.Point{2, 2}},
+		{Min: orb.Point{math.Inf(-1), 0.4}, Max: orb.Point{math.Inf(1), 0.6}},
+		{Min: orb.Point{3, 3}, Max: orb.Point{4, 4}},
+		{Min: orb.Point{1, 1}, Max: orb.Point{0, 0}},
+	}
+	for i := 0; i < 100; i++ {
+		b := orb.Bound{Min: orb.Point{r.Float64(), r.Float64()}}
+		b.Max = orb.Point{b.Min[0] + r.Float64()/2, b.Min[1] + r.Float64()/2}
+		bounds = append(bounds, b)
+	}
+
+	var buf []orb.Pointer
+	for _, b := range bounds {
+		buf = h.InBound(buf, b)
+		expected := qt.InBound(nil, b)
+		if !samePointers(buf, expected) {
+			t.Errorf("incorrect points in %v: %d != %d", b, len(buf), len(expected))
+		}
+	}
+}
+
+func TestSpatialHashNear(t *testing.T) {
+	r := rand.New(rand.NewSource(43))
+
+	h := New(0.1)
+	qt := quadtree.New(orb.Bound{Min: orb.Point{-1, -1}, Max: orb.Point{1, 1}})
+	for i := 0; i < 1000; i++ {
+		p := orb.Point{2*r.Float64() - 1, 2*r.Float64() - 1}
+		h.Insert(p)
+		qt.Add(p)
+	}
+
+	for i := 0; i < 100; i++ {
+		p := orb.Point{2*r.Float64() - 1, 2*r.Float64() - 1}
+		radius := r.Float64() / 4
+
+		b := orb.Bound{Min: p, Max: p}.Pad(radius)
+		expected := qt.InBoundMatching(nil, b, func(q orb.Pointer) bool {
+			return planar.DistanceSquared(p, q.Point()) <= radius*radius
+		})
+
+		if near := h.Near(nil, p, radius); !samePointers(near, expected) {
+			t.Errorf("incorrect points near %v: %d != %d", p, len(near), len(expected))
+		}
+	}
+
+	if near := h.Near(nil, orb.Point{}, -1); len(near) != 0 {
+		t.Errorf("should be empty for negative radius: %v", near)
+	}
+}
+
+func samePointers(a, b []orb.Pointer) bool {
+	if len(a) != len(b) {
+		return false
+	}
+
+	sorted := func(ps []orb.Pointer) []orb.Point {
+		result := make([]orb.Point, len(ps))
+		for i, p := range ps {
+			result[i] = p.Point()
+		}
+
+		sort.Slice(result, func(i, j int) bool {
+			if result[i][0] != result[j][0] {
+				return result[i][0] < result[j][0]
+			}
+			return result[i][1] < result[j][1]
+		})
+
+		return result
+	}
+
+	pa, pb := sorted(a), sorted(b)
+	for i := range pa {
+		if pa[i] != pb[i] {
+			return false
+		}
+	}
+
+	return true
+}
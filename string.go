@@ -0,0 +1,207 @@
+package orb
+
+import (
+	"fmt"
+	"strconv"
+)
+
+// maxStringPoints is the number of points, or rings, written by the String
+// methods before the output is truncated with a count of the remaining items.
+const maxStringPoints = 10
+
+// String returns a WKT like representation of the point, e.g. "POINT(1 2)".
+func (p Point) String() string {
+	buf := make([]byte, 0, 32)
+	buf = append(buf, "POINT("...)
+	buf = appendCoord(buf, p, ' ')
+	buf = append(buf, ')')
+
+	return string(buf)
+}
+
+// String returns a WKT like representation of the multi point.
+// Large multi points are truncated with a count of the remaining points.
+func (mp MultiPoint) String() string {
+	if len(mp) == 0 {
+		return "MULTIPOINT EMPTY"
+	}
+
+	buf := make([]byte, 0, 128)
+	buf = append(buf, "MULTIPOINT"...)
+	buf = appendCoords(buf, mp)
+
+	return string(buf)
+}
+
+// String returns a WKT like representation of the line string.
+// Large line strings are truncated with a count of the remaining points.
+func (ls LineString) String() string {
+	if len(ls) == 0 {
+		return "LINESTRING EMPTY"
+	}
+
+	buf := make([]byte, 0, 128)
+	buf = append(buf, "LINESTRING"...)
+	buf = appendCoords(buf, ls)
+
+	return string(buf)
+}
+
+// String returns a WKT like representation of the ring as a polygon.
+// Large rings are truncated with a count of the remaining points.
+func (r Ring) String() string {
+	return Polygon{r}.String()
+}
+
+// String returns a WKT like representation of the polygon.
+// Large polygons are truncated with a count of the remaining points and rings.
+func (p Polygon) String() string {
+	if len(p) == 0 {
+		return "POLYGON EMPTY"
+	}
+
+	buf := make([]byte, 0, 128)
+	buf = append(buf, "POLYGON"...)
+	buf = appendRings(buf, p)
+
+	return string(buf)
+}
+
+// String returns a WKT like representation of the multi line string.
+// Large multi line strings are truncated with a count of the remaining
+// points and line strings.
+func (mls MultiLineString) String() string {
+	if len(mls) == 0 {
+		return "MULTILINESTRING EMPTY"
+	}
+
+	buf := make([]byte, 0, 128)
+	buf = append(buf, "MULTILINESTRING("...)
+	for i, ls := range mls {
+		if i == maxStringPoints {
+			buf = appendRemaining(buf, len(mls)-i)
+			break
+		}
+
+		if i != 0 {
+			buf = append(buf, ',')
+		}
+		buf = appendCoords(buf, ls)
+	}
+	buf = append(buf, ')')
+
+	return string(buf)
+}
+
+// String returns a WKT like representation of the multi polygon.
+// Large multi polygons are truncated with a count of the remaining
+// points, rings and polygons.
+func (mp MultiPolygon) String() string {
+	if len(mp) == 0 {
+		return "MULTIPOLYGON EMPTY"
+	}
+
+	buf := make([]byte, 0, 128)
+	buf = append(buf, "MULTIPOLYGON("...)
+	for i, p := range mp {
+		if i == maxStringPoints {
+			buf = appendRemaining(buf, len(mp)-i)
+			break
+		}
+
+		if i != 0 {
+			buf = append(buf, ',')
+		}
+		buf = appendRings(buf, p)
+	}
+	buf = append(buf, ')')
+
+	return string(buf)
+}
+
+// String returns a WKT like representation of the collection. Bounds are
+// written as polygons. Large collections are truncated with a count of
+// the remaining geometries.
+func (c Collection) String() string {
+	if len(c) == 0 {
+		return "GEOMETRYCOLLECTION EMPTY"
+	}
+
+	buf := make([]byte, 0, 128)
+	buf = append(buf, "GEOMETRYCOLLECTION("...)
+	for i, g := range c {
+		if i == maxStringPoints {
+			buf = appendRemaining(buf, len(c)-i)
+			break
+		}
+
+		if i != 0 {
+			buf = append(buf, ',')
+		}
+
+		switch g := g.(type) {
+		case Bound:
+			buf = append(buf, g.WKT()...)
+		case nil:
+			buf = append(buf, "GEOMETRYCOLLECTION EMPTY"...)
+		default:
+			buf = append(buf, g.(fmt.Stringer).String()...)
+		}
+	}
+	buf = append(buf, ')')
+
+	return string(buf)
+}
+
+// appendRings appends the rings of the polygon to the buffer within
+// parentheses, truncating the list if it is too long.
+func appendRings(buf []byte, p Polygon) []byte {
+	buf = append(buf, '(')
+	for i, r := range p {
+		if i == maxStringPoints {
+			buf = appendRemaining(buf, len(p)-i)
+			break
+		}
+
+		if i != 0 {
+			buf = append(buf, ',')
+		}
+		buf = appendCoords(buf, r)
+	}
+
+	return append(buf, ')')
+}
+
+// appendCoords appends the points to the buffer within parentheses,
+// truncating the list if it is too long.
+func appendCoords(buf []byte, ps []Point) []byte {
+	buf = append(buf, '(')
+	for i, p := range ps {
+		if i == maxStringPoints {
+			buf = appendRemaining(buf, len(ps)-i)
+			break
+		}
+
+		if i != 0 {
+			buf = append(buf, ',')
+		}
+		buf = appendCoord(buf, p, ' ')
+	}
+
+	return append(buf, ')')
+}
+
+// appendRemaining appends the suffix used to note truncated output.
+func appendRemaining(buf []byte, n int) []byte {
+	buf = append(buf, ",...+"...)
+	buf = strconv.AppendInt(buf, int64(n), 10)
+	return append(buf, " more"...)
+}
+
+// appendCoord appends the two coordinates of the point to the buffer
+// using the shortest representation and the given separator.
+func appendCoord(buf []byte, p Point, sep byte) []byte {
+	buf = strconv.AppendFloat(buf, p[0], 'g', -1, 64)
+	buf = append(buf, sep)
+	return strconv.AppendFloat(buf, p[1], 'g', -1, 64)
+}
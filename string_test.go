@@ -0,0 +1,116 @@
+package orb
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestString(t *testing.T) {
+	long := make(LineString, 0, 15)
+	for i := 0; i < 15; i++ {
+		long = append(long, Point{float64(i), 0})
+	}
+
+	cases := []struct {
+		name     string
+		geom     fmt.Stringer
+		expected string
+	}{
+		{
+			name:     "point",
+			geom:     Point{1.5, -2},
+			expected: "POINT(1.5 -2)",
+		},
+		{
+			name:     "multi point",
+			geom:     MultiPoint{{1, 2}, {3, 4}},
+			expected: "MULTIPOINT(1 2,3 4)",
+		},
+		{
+			name:     "empty multi point",
+			geom:     MultiPoint{},
+			expected: "MULTIPOINT EMPTY",
+		},
+		{
+			name:     "line string",
+			geom:     LineString{{1, 2}, {3, 4}},
+			expected: "LINESTRING(1 2,3 4)",
+		},
+		{
+			name:     "empty line string",
+			geom:     LineString(nil),
+			expected: "LINESTRING EMPTY",
+		},
+		{
+			name:     "truncated line string",
+			geom:     long,
+			expected: "LINESTRING(0 0,1 0,2 0,3 0,4 0,5 0,6 0,7 0,8 0,9 0,...+5 more)",
+		},
+		{
+			name:     "ring",
+			geom:     Ring{{0, 0}, {1, 0}, {1, 1}, {0, 0}},
+			expected: "POLYGON((0 0,1 0,1 1,0 0))",
+		},
+		{
+			name:     "polygon",
+			geom:     Polygon{{{0, 0}, {3, 0}, {3, 3}, {0, 0}}, {{1, 1}, {2, 1}, {2, 2}, {1, 1}}},
+			expected: "POLYGON((0 0,3 0,3 3,0 0),(1 1,2 1,2 2,1 1))",
+		},
+		{
+			name:     "empty polygon",
+			geom:     Polygon{},
+			expected: "POLYGON EMPTY",
+		},
+		{
+			name:     "multi line string",
+			geom:     MultiLineString{{{1, 2}, {3, 4}}, {{5, 6}, {7, 8}}},
+			expected: "MULTILINESTRING((1 2,3 4),(5 6,7 8))",
+		},
+		{
+			name:     "truncated multi line string",
+			geom:     MultiLineString{long},
+			expected: "MULTILINESTRING((0 0,1 0,2 0,3 0,4 0,5 0,6 0,7 0,8 0,9 0,...+5 more))",
+		},
+		{
+			name:     "empty multi line string",
+			geom:     MultiLineString{},
+			expected: "MULTILINESTRING EMPTY",
+		},
+		{
+			name: "multi polygon",
+			geom: MultiPolygon{
+				{{{0, 0}, {1, 0}, {1, 1}, {0, 0}}},
+				{{{2, 2}, {3, 2}, {3, 3}, {2, 2}}},
+			},
+			expected: "MULTIPOLYGON(((0 0,1 0,1 1,0 0)),((2 2,3 2,3 3,2 2)))",
+		},
+		{
+			name:     "empty multi polygon",
+			geom:     MultiPolygon{},
+			expected: "MULTIPOLYGON EMPTY",
+		},
+		{
+			name: "collection",
+			geom: Collection{
+				Point{1, 2},
+				LineString{{1, 2}, {3, 4}},
+				Bound{Min: Point{0, 0}, Max: Point{1, 1}},
+				Collection{},
+			},
+			expected: "GEOMETRYCOLLECTION(POINT(1 2),LINESTRING(1 2,3 4),POLYGON((0 0,1 0,1 1,0 1,0 0)),GEOMETRYCOLLECTION EMPTY)",
+		},
+		{
+			name:     "empty collection",
+			geom:     Collection{},
+			expected: "GEOMETRYCOLLECTION EMPTY",
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			if v := tc.geom.String(); v != tc.expected {
+				t.Errorf("incorrect string: %v != %v", v, tc.expected)
+			}
+		})
+	}
+}